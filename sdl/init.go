@@ -16,12 +16,12 @@ var (
 	sdlAcquireGPUCommandBuffer    func(*GPUDevice) *GPUCommandBuffer
 	sdlAcquireGPUSwapchainTexture func(*GPUCommandBuffer, *Window, **GPUTexture, *uint32, *uint32) bool
 	// sdlAddAtomicInt                          func(*AtomicInt, int32) int32
-	sdlAddEventWatch func(EventFilter, unsafe.Pointer) bool
-	// sdlAddGamepadMapping                     func(string) int32
-	// sdlAddGamepadMappingsFromFile            func(string) int32
-	// sdlAddGamepadMappingsFromIO              func(*IOStream, bool) int32
-	sdlAddHintCallback          func(string, HintCallback, unsafe.Pointer) bool
-	sdlAddSurfaceAlternateImage func(*Surface, *Surface) bool
+	sdlAddEventWatch              func(EventFilter, unsafe.Pointer) bool
+	sdlAddGamepadMapping          func(string) int32
+	sdlAddGamepadMappingsFromFile func(string) int32
+	sdlAddGamepadMappingsFromIO   func(*IOStream, bool) int32
+	sdlAddHintCallback            func(string, HintCallback, unsafe.Pointer) bool
+	sdlAddSurfaceAlternateImage   func(*Surface, *Surface) bool
 	// sdlAddTimer                              func(uint32, TimerCallback, unsafe.Pointer) TimerID
 	// sdlAddTimerNS                            func(uint64, NSTimerCallback, unsafe.Pointer) TimerID
 	sdlAddVulkanRenderSemaphores func(*Renderer, uint32, int64, int64) bool
@@ -30,14 +30,14 @@ var (
 	// sdlasin                                  func(float64) float64
 	// sdlasinf                                 func(float32) float32
 	// sdlasprintf                              func(**byte, string) int32
-	// sdlAsyncIOFromFile                       func(string, string) *AsyncIO
+	sdlAsyncIOFromFile func(string, string) *AsyncIO
 	// sdlatan                                  func(float64) float64
 	// sdlatan2                                 func(float64, float64) float64
 	// sdlatan2f                                func(float32, float32) float32
 	// sdlatanf                                 func(float32) float32
 	// sdlatof                                  func(string) float64
 	// sdlatoi                                  func(string) int32
-	// sdlAttachVirtualJoystick                 func(*VirtualJoystickDesc) JoystickID
+	sdlAttachVirtualJoystick func(*virtualJoystickDesc) JoystickID
 	// sdlAudioDevicePaused                     func(AudioDeviceID) bool
 	sdlAudioStreamDevicePaused uintptr
 	// sdlBeginGPUComputePass                   func(*GPUCommandBuffer, *GPUStorageTextureReadWriteBinding, uint32, *GPUStorageBufferReadWriteBinding, uint32) *GPUComputePass
@@ -84,15 +84,15 @@ var (
 	sdlClearProperty    func(PropertiesID, string) bool
 	// sdlClearSurface                          func(*Surface, float32, float32, float32, float32) bool
 	// sdlClickTrayEntry                        func(*TrayEntry)
-	// sdlCloseAsyncIO                          func(*AsyncIO, bool, *AsyncIOQueue, unsafe.Pointer) bool
+	sdlCloseAsyncIO func(*AsyncIO, bool, *AsyncIOQueue, uintptr) bool
 	// sdlCloseAudioDevice                      func(AudioDeviceID)
-	sdlCloseCamera  func(*Camera)
-	sdlCloseGamepad func(*Gamepad)
-	// sdlCloseHaptic                           func(*Haptic)
+	sdlCloseCamera   func(*Camera)
+	sdlCloseGamepad  func(*Gamepad)
+	sdlCloseHaptic   func(*Haptic)
 	sdlCloseIO       func(*IOStream) bool
 	sdlCloseJoystick func(*Joystick)
-	// sdlCloseSensor                           func(*Sensor)
-	// sdlCloseStorage                          func(*Storage) bool
+	sdlCloseSensor   func(*Sensor)
+	sdlCloseStorage  func(*Storage) bool
 	// sdlCompareAndSwapAtomicInt               func(*AtomicInt, int32, int32) bool
 	// sdlCompareAndSwapAtomicPointer           func(*unsafe.Pointer, unsafe.Pointer, unsafe.Pointer) bool
 	// sdlCompareAndSwapAtomicU32               func(*AtomicU32, uint32, uint32) bool
@@ -103,25 +103,25 @@ var (
 	sdlConvertPixelsAndColorspace      func(int32, int32, PixelFormat, Colorspace, PropertiesID, unsafe.Pointer, int32, PixelFormat, Colorspace, PropertiesID, unsafe.Pointer, int32) bool
 	sdlConvertSurface                  func(*Surface, PixelFormat) *Surface
 	sdlConvertSurfaceAndColorspace     func(*Surface, PixelFormat, *Palette, Colorspace, PropertiesID) *Surface
-	// sdlCopyFile                              func(string, string) bool
+	sdlCopyFile                        func(string, string) bool
 	// sdlCopyGPUBufferToBuffer                 func(*GPUCopyPass, *GPUBufferLocation, *GPUBufferLocation, uint32, bool)
 	// sdlCopyGPUTextureToTexture               func(*GPUCopyPass, *GPUTextureLocation, *GPUTextureLocation, uint32, uint32, uint32, bool)
 	sdlCopyProperties func(PropertiesID, PropertiesID) bool
 	// sdlcopysign                              func(float64, float64) float64
 	// sdlcopysignf                             func(float32, float32) float32
-	// sdlCopyStorageFile                       func(*Storage, string, string) bool
+	sdlCopyStorageFile func(*Storage, string, string) bool
 	// sdlcos                                   func(float64) float64
 	// sdlcosf                                  func(float32) float32
 	// sdlcrc16                                 func(uint16, unsafe.Pointer, uint64) uint16
 	// sdlcrc32                                 func(uint32, unsafe.Pointer, uint64) uint32
-	// sdlCreateAsyncIOQueue                    func() *AsyncIOQueue
+	sdlCreateAsyncIOQueue func() *AsyncIOQueue
 	// sdlCreateAudioStream                     func(*AudioSpec, *AudioSpec) *AudioStream
 	sdlCreateColorCursor func(*Surface, int32, int32) *Cursor
 	// sdlCreateCondition                       func() *Condition
-	sdlCreateCursor func(*uint8, *uint8, int32, int32, int32, int32) *Cursor
-	// sdlCreateDirectory                       func(string) bool
-	// sdlCreateEnvironment                     func(bool) *Environment
-	sdlCreateGPUBuffer func(*GPUDevice, *GPUBufferCreateInfo) *GPUBuffer
+	sdlCreateCursor      func(*uint8, *uint8, int32, int32, int32, int32) *Cursor
+	sdlCreateDirectory   func(string) bool
+	sdlCreateEnvironment func(bool) *Environment
+	sdlCreateGPUBuffer   func(*GPUDevice, *GPUBufferCreateInfo) *GPUBuffer
 	// sdlCreateGPUComputePipeline              func(*GPUDevice, *GPUComputePipelineCreateInfo) *GPUComputePipeline
 	sdlCreateGPUDevice func(GPUShaderFormat, bool, *byte) *GPUDevice
 	// sdlCreateGPUDeviceWithProperties         func(PropertiesID) *GPUDevice
@@ -130,19 +130,19 @@ var (
 	sdlCreateGPUShader           func(*GPUDevice, *GPUShaderCreateInfo) *GPUShader
 	sdlCreateGPUTexture          func(*GPUDevice, *GPUTextureCreateInfo) *GPUTexture
 	sdlCreateGPUTransferBuffer   func(*GPUDevice, *GPUTransferBufferCreateInfo) *GPUTransferBuffer
-	// sdlCreateHapticEffect                    func(*Haptic, *HapticEffect) int32
+	sdlCreateHapticEffect        func(*Haptic, *HapticEffect) int32
 	// sdlCreateMutex                           func() *Mutex
 	sdlCreatePalette func(int32) *Palette
 	// sdlCreatePopupWindow                     func(*Window, int32, int32, int32, int32, WindowFlags) *Window
-	// sdlCreateProcess                         func(**byte, bool) *Process
-	// sdlCreateProcessWithProperties           func(PropertiesID) *Process
+	sdlCreateProcess                func(**byte, bool) *Process
+	sdlCreateProcessWithProperties  func(PropertiesID) *Process
 	sdlCreateProperties             func() PropertiesID
 	sdlCreateRenderer               func(*Window, *byte) *Renderer
 	sdlCreateRendererWithProperties func(PropertiesID) *Renderer
 	// sdlCreateRWLock                          func() *RWLock
 	// sdlCreateSemaphore                       func(uint32) *Semaphore
-	sdlCreateSoftwareRenderer func(*Surface) *Renderer
-	// sdlCreateStorageDirectory                func(*Storage, string) bool
+	sdlCreateSoftwareRenderer      func(*Surface) *Renderer
+	sdlCreateStorageDirectory      func(*Storage, string) bool
 	sdlCreateSurface               func(int32, int32, PixelFormat) *Surface
 	sdlCreateSurfaceFrom           func(int32, int32, PixelFormat, unsafe.Pointer, int32) *Surface
 	sdlCreateSurfacePalette        func(*Surface) *Palette
@@ -160,19 +160,19 @@ var (
 	// sdlCreateWindowWithProperties            func(PropertiesID) *Window
 	sdlCursorVisible func() bool
 	// sdlDateTimeToTime                        func(*DateTime, *Time) bool
-	// sdlDelay                                 func(uint32)
+	sdlDelay   func(uint32)
 	sdlDelayNS func(uint64)
 	// sdlDelayPrecise                          func(uint64)
-	// sdlDestroyAsyncIOQueue                   func(*AsyncIOQueue)
-	sdlDestroyAudioStream func(*AudioStream)
+	sdlDestroyAsyncIOQueue func(*AsyncIOQueue)
+	sdlDestroyAudioStream  func(*AudioStream)
 	// sdlDestroyCondition                      func(*Condition)
-	sdlDestroyCursor func(*Cursor)
-	// sdlDestroyEnvironment                    func(*Environment)
-	sdlDestroyGPUDevice func(*GPUDevice)
-	// sdlDestroyHapticEffect                   func(*Haptic, int32)
+	sdlDestroyCursor       func(*Cursor)
+	sdlDestroyEnvironment  func(*Environment)
+	sdlDestroyGPUDevice    func(*GPUDevice)
+	sdlDestroyHapticEffect func(*Haptic, int32)
 	// sdlDestroyMutex                          func(*Mutex)
-	sdlDestroyPalette func(*Palette)
-	// sdlDestroyProcess                        func(*Process)
+	sdlDestroyPalette    func(*Palette)
+	sdlDestroyProcess    func(*Process)
 	sdlDestroyProperties func(PropertiesID)
 	sdlDestroyRenderer   func(*Renderer)
 	// sdlDestroyRWLock                         func(*RWLock)
@@ -183,7 +183,7 @@ var (
 	sdlDestroyWindow func(*Window)
 	// sdlDestroyWindowSurface                  func(*Window) bool
 	// sdlDetachThread                          func(*Thread)
-	// sdlDetachVirtualJoystick                 func(JoystickID) bool
+	sdlDetachVirtualJoystick func(JoystickID) bool
 	// sdlDisableScreenSaver                    func() bool
 	// sdlDispatchGPUCompute                    func(*GPUComputePass, uint32, uint32, uint32)
 	// sdlDispatchGPUComputeIndirect            func(*GPUComputePass, *GPUBuffer, uint32)
@@ -204,10 +204,10 @@ var (
 	sdlEndGPUCopyPass   func(*GPUCopyPass)
 	sdlEndGPURenderPass func(*GPURenderPass)
 	// sdlEnterAppMainCallbacks                 func(int32, **byte, AppInit_func, AppIterate_func, AppEvent_func, AppQuit_func) int32
-	// sdlEnumerateDirectory                    func(string, EnumerateDirectoryCallback, unsafe.Pointer) bool
-	sdlEnumerateProperties func(PropertiesID, EnumeratePropertiesCallback, unsafe.Pointer) bool
-	// sdlEnumerateStorageDirectory             func(*Storage, string, EnumerateDirectoryCallback, unsafe.Pointer) bool
-	sdlEventEnabled func(EventType) bool
+	sdlEnumerateDirectory        func(string, uintptr, uintptr) bool
+	sdlEnumerateProperties       func(PropertiesID, EnumeratePropertiesCallback, unsafe.Pointer) bool
+	sdlEnumerateStorageDirectory func(*Storage, *byte, uintptr, uintptr) bool
+	sdlEventEnabled              func(EventType) bool
 	// sdlexp                                   func(float64) float64
 	// sdlexpf                                  func(float32) float32
 	// sdlfabs                                  func(float64) float64
@@ -222,24 +222,24 @@ var (
 	sdlFlushAudioStream uintptr
 	sdlFlushEvent       func(EventType)
 	sdlFlushEvents      func(EventType, EventType)
-	// sdlFlushIO                               func(*IOStream) bool
-	sdlFlushRenderer uintptr
+	sdlFlushIO          func(*IOStream) bool
+	sdlFlushRenderer    uintptr
 	// sdlfmod                                  func(float64, float64) float64
 	// sdlfmodf                                 func(float32, float32) float32
-	sdlfree uintptr
-	// sdlGamepadConnected                      func(*Gamepad) bool
-	// sdlGamepadEventsEnabled                  func() bool
-	// sdlGamepadHasAxis                        func(*Gamepad, GamepadAxis) bool
-	// sdlGamepadHasButton                      func(*Gamepad, GamepadButton) bool
-	// sdlGamepadHasSensor                      func(*Gamepad, SensorType) bool
-	// sdlGamepadSensorEnabled                  func(*Gamepad, SensorType) bool
+	sdlfree                 uintptr
+	sdlGamepadConnected     func(*Gamepad) bool
+	sdlGamepadEventsEnabled func() bool
+	sdlGamepadHasAxis       func(*Gamepad, GamepadAxis) bool
+	sdlGamepadHasButton     func(*Gamepad, GamepadButton) bool
+	sdlGamepadHasSensor     func(*Gamepad, SensorType) bool
+	sdlGamepadSensorEnabled func(*Gamepad, SensorType) bool
 	// sdlGDKSuspendComplete                    func()
 	// sdlGenerateMipmapsForGPUTexture          func(*GPUCommandBuffer, *GPUTexture)
 	sdlGetAppMetadataProperty func(string) string
 	// sdlGetAssertionHandler                   func(*unsafe.Pointer) AssertionHandler
 	// sdlGetAssertionReport                    func() *AssertData
-	// sdlGetAsyncIOResult                      func(*AsyncIOQueue, *AsyncIOOutcome) bool
-	// sdlGetAsyncIOSize                        func(*AsyncIO) int64
+	sdlGetAsyncIOResult func(*AsyncIOQueue, *AsyncIOOutcome) bool
+	sdlGetAsyncIOSize   func(*AsyncIO) int64
 	// sdlGetAtomicInt                          func(*AtomicInt) int32
 	// sdlGetAtomicPointer                      func(*unsafe.Pointer) unsafe.Pointer
 	// sdlGetAtomicU32                          func(*AtomicU32) uint32
@@ -306,90 +306,90 @@ var (
 	// sdlGetDisplayUsableBounds                func(DisplayID, *Rect) bool
 	// sdlgetenv                                func(string) string
 	// sdlgetenv_unsafe                         func(string) string
-	// sdlGetEnvironment                        func() *Environment
-	// sdlGetEnvironmentVariable                func(*Environment, string) string
-	// sdlGetEnvironmentVariables               func(*Environment) **byte
-	sdlGetError                  func() string
-	sdlGetEventFilter            func(*EventFilter, *unsafe.Pointer) bool
-	sdlGetFloatProperty          func(PropertiesID, string, float32) float32
-	sdlGetFullscreenDisplayModes func(DisplayID, *int32) **DisplayMode
-	// sdlGetGamepadAppleSFSymbolsNameForAxis   func(*Gamepad, GamepadAxis) string
-	// sdlGetGamepadAppleSFSymbolsNameForButton func(*Gamepad, GamepadButton) string
-	// sdlGetGamepadAxis                        func(*Gamepad, GamepadAxis) int16
-	// sdlGetGamepadAxisFromString              func(string) GamepadAxis
-	sdlGetGamepadBindings func(*Gamepad, *int32) **GamepadBinding
-	// sdlGetGamepadButton                      func(*Gamepad, GamepadButton) bool
-	// sdlGetGamepadButtonFromString            func(string) GamepadButton
-	// sdlGetGamepadButtonLabel                 func(*Gamepad, GamepadButton) GamepadButtonLabel
-	// sdlGetGamepadButtonLabelForType          func(GamepadType, GamepadButton) GamepadButtonLabel
-	// sdlGetGamepadConnectionState             func(*Gamepad) JoystickConnectionState
-	// sdlGetGamepadFirmwareVersion             func(*Gamepad) uint16
-	sdlGetGamepadFromID func(JoystickID) *Gamepad
-	// sdlGetGamepadFromPlayerIndex             func(int32) *Gamepad
-	// sdlGetGamepadGUIDForID                   func(JoystickID) GUID
-	// sdlGetGamepadID                          func(*Gamepad) JoystickID
-	// sdlGetGamepadJoystick                    func(*Gamepad) *Joystick
-	// sdlGetGamepadMapping                     func(*Gamepad) string
-	// sdlGetGamepadMappingForGUID              func(GUID) string
-	// sdlGetGamepadMappingForID                func(JoystickID) string
-	// sdlGetGamepadMappings                    func(*int32) **byte
-	sdlGetGamepadName      func(*Gamepad) string
-	sdlGetGamepadNameForID func(JoystickID) string
-	// sdlGetGamepadPath                        func(*Gamepad) string
-	// sdlGetGamepadPathForID                   func(JoystickID) string
-	// sdlGetGamepadPlayerIndex                 func(*Gamepad) int32
-	// sdlGetGamepadPlayerIndexForID            func(JoystickID) int32
-	// sdlGetGamepadPowerInfo                   func(*Gamepad, *int32) PowerState
-	// sdlGetGamepadProduct                     func(*Gamepad) uint16
-	// sdlGetGamepadProductForID                func(JoystickID) uint16
-	// sdlGetGamepadProductVersion              func(*Gamepad) uint16
-	// sdlGetGamepadProductVersionForID         func(JoystickID) uint16
-	// sdlGetGamepadProperties                  func(*Gamepad) PropertiesID
-	sdlGetGamepads func(*int32) *JoystickID
-	// sdlGetGamepadSensorData                  func(*Gamepad, SensorType, *float32, int32) bool
-	// sdlGetGamepadSensorDataRate              func(*Gamepad, SensorType) float32
-	sdlGetGamepadSerial func(*Gamepad) string
-	// sdlGetGamepadSteamHandle                 func(*Gamepad) uint64
-	// sdlGetGamepadStringForAxis               func(GamepadAxis) string
-	sdlGetGamepadStringForButton func(GamepadButton) string
-	sdlGetGamepadStringForType   func(GamepadType) string
-	// sdlGetGamepadTouchpadFinger              func(*Gamepad, int32, int32, *bool, *float32, *float32, *float32) bool
-	sdlGetGamepadType func(*Gamepad) GamepadType
-	// sdlGetGamepadTypeForID                   func(JoystickID) GamepadType
-	// sdlGetGamepadTypeFromString              func(string) GamepadType
-	// sdlGetGamepadVendor                      func(*Gamepad) uint16
-	// sdlGetGamepadVendorForID                 func(JoystickID) uint16
-	sdlGetGlobalMouseState          func(*float32, *float32) MouseButtonFlags
-	sdlGetGlobalProperties          func() PropertiesID
-	sdlGetGPUDeviceDriver           func(*GPUDevice) string
-	sdlGetGPUDeviceProperties       func(*GPUDevice) PropertiesID
-	sdlGetGPUDriver                 func(int32) string
-	sdlGetGPUShaderFormats          func(*GPUDevice) GPUShaderFormat
-	sdlGetGPUSwapchainTextureFormat func(*GPUDevice, *Window) GPUTextureFormat
+	sdlGetEnvironment                        func() *Environment
+	sdlGetEnvironmentVariable                func(*Environment, string) string
+	sdlGetEnvironmentVariables               func(*Environment) **byte
+	sdlGetError                              func() string
+	sdlGetEventFilter                        func(*EventFilter, *unsafe.Pointer) bool
+	sdlGetFloatProperty                      func(PropertiesID, string, float32) float32
+	sdlGetFullscreenDisplayModes             func(DisplayID, *int32) **DisplayMode
+	sdlGetGamepadAppleSFSymbolsNameForAxis   func(*Gamepad, GamepadAxis) string
+	sdlGetGamepadAppleSFSymbolsNameForButton func(*Gamepad, GamepadButton) string
+	sdlGetGamepadAxis                        func(*Gamepad, GamepadAxis) int16
+	sdlGetGamepadAxisFromString              func(string) GamepadAxis
+	sdlGetGamepadBindings                    func(*Gamepad, *int32) **GamepadBinding
+	sdlGetGamepadButton                      func(*Gamepad, GamepadButton) bool
+	sdlGetGamepadButtonFromString            func(string) GamepadButton
+	sdlGetGamepadButtonLabel                 func(*Gamepad, GamepadButton) GamepadButtonLabel
+	sdlGetGamepadButtonLabelForType          func(GamepadType, GamepadButton) GamepadButtonLabel
+	sdlGetGamepadConnectionState             func(*Gamepad) JoystickConnectionState
+	sdlGetGamepadFirmwareVersion             func(*Gamepad) uint16
+	sdlGetGamepadFromID                      func(JoystickID) *Gamepad
+	sdlGetGamepadFromPlayerIndex             func(int32) *Gamepad
+	sdlGetGamepadGUIDForID                   uintptr
+	sdlGetGamepadID                          func(*Gamepad) JoystickID
+	sdlGetGamepadJoystick                    func(*Gamepad) *Joystick
+	sdlGetGamepadMapping                     func(*Gamepad) *byte
+	sdlGetGamepadMappingForGUID              uintptr
+	sdlGetGamepadMappingForID                func(JoystickID) *byte
+	sdlGetGamepadMappings                    func(*int32) **byte
+	sdlGetGamepadName                        func(*Gamepad) string
+	sdlGetGamepadNameForID                   func(JoystickID) string
+	sdlGetGamepadPath                        func(*Gamepad) string
+	sdlGetGamepadPathForID                   func(JoystickID) string
+	sdlGetGamepadPlayerIndex                 func(*Gamepad) int32
+	sdlGetGamepadPlayerIndexForID            func(JoystickID) int32
+	sdlGetGamepadPowerInfo                   func(*Gamepad, *int32) PowerState
+	sdlGetGamepadProduct                     func(*Gamepad) uint16
+	sdlGetGamepadProductForID                func(JoystickID) uint16
+	sdlGetGamepadProductVersion              func(*Gamepad) uint16
+	sdlGetGamepadProductVersionForID         func(JoystickID) uint16
+	sdlGetGamepadProperties                  func(*Gamepad) PropertiesID
+	sdlGetGamepads                           func(*int32) *JoystickID
+	sdlGetGamepadSensorData                  func(*Gamepad, SensorType, *float32, int32) bool
+	sdlGetGamepadSensorDataRate              func(*Gamepad, SensorType) float32
+	sdlGetGamepadSerial                      func(*Gamepad) string
+	sdlGetGamepadSteamHandle                 func(*Gamepad) uint64
+	sdlGetGamepadStringForAxis               func(GamepadAxis) string
+	sdlGetGamepadStringForButton             func(GamepadButton) string
+	sdlGetGamepadStringForType               func(GamepadType) string
+	sdlGetGamepadTouchpadFinger              func(*Gamepad, int32, int32, *bool, *float32, *float32, *float32) bool
+	sdlGetGamepadType                        func(*Gamepad) GamepadType
+	sdlGetGamepadTypeForID                   func(JoystickID) GamepadType
+	sdlGetGamepadTypeFromString              func(string) GamepadType
+	sdlGetGamepadVendor                      func(*Gamepad) uint16
+	sdlGetGamepadVendorForID                 func(JoystickID) uint16
+	sdlGetGlobalMouseState                   func(*float32, *float32) MouseButtonFlags
+	sdlGetGlobalProperties                   func() PropertiesID
+	sdlGetGPUDeviceDriver                    func(*GPUDevice) string
+	sdlGetGPUDeviceProperties                func(*GPUDevice) PropertiesID
+	sdlGetGPUDriver                          func(int32) string
+	sdlGetGPUShaderFormats                   func(*GPUDevice) GPUShaderFormat
+	sdlGetGPUSwapchainTextureFormat          func(*GPUDevice, *Window) GPUTextureFormat
 	// sdlGetGrabbedWindow                      func() *Window
-	// sdlGetHapticEffectStatus                 func(*Haptic, int32) bool
-	// sdlGetHapticFeatures                     func(*Haptic) uint32
-	// sdlGetHapticFromID                       func(HapticID) *Haptic
-	// sdlGetHapticID                           func(*Haptic) HapticID
-	// sdlGetHapticName                         func(*Haptic) string
-	// sdlGetHapticNameForID                    func(HapticID) string
-	// sdlGetHaptics                            func(*int32) *HapticID
-	sdlGetHint        func(string) string
-	sdlGetHintBoolean func(string, bool) bool
-	// sdlGetIOProperties                       func(*IOStream) PropertiesID
-	// sdlGetIOSize                             func(*IOStream) int64
-	// sdlGetIOStatus                           func(*IOStream) IOStatus
-	sdlGetJoystickAxis             func(*Joystick, int32) int16
-	sdlGetJoystickAxisInitialState func(*Joystick, int32, *int16) bool
-	sdlGetJoystickBall             func(*Joystick, int32, *int32, *int32) bool
-	sdlGetJoystickButton           func(*Joystick, int32) bool
-	sdlGetJoystickConnectionState  func(*Joystick) JoystickConnectionState
-	sdlGetJoystickFirmwareVersion  func(*Joystick) uint16
-	sdlGetJoystickFromID           func(JoystickID) *Joystick
-	sdlGetJoystickFromPlayerIndex  func(int32) *Joystick
-	// sdlGetJoystickGUID                func(*Joystick) GUID
-	// sdlGetJoystickGUIDForID           func(JoystickID) GUID
-	// sdlGetJoystickGUIDInfo            func(GUID, *uint16, *uint16, *uint16, *uint16)
+	sdlGetHapticEffectStatus          func(*Haptic, int32) bool
+	sdlGetHapticFeatures              func(*Haptic) uint32
+	sdlGetHapticFromID                func(HapticID) *Haptic
+	sdlGetHapticID                    func(*Haptic) HapticID
+	sdlGetHapticName                  func(*Haptic) string
+	sdlGetHapticNameForID             func(HapticID) string
+	sdlGetHaptics                     func(*int32) *HapticID
+	sdlGetHint                        func(string) string
+	sdlGetHintBoolean                 func(string, bool) bool
+	sdlGetIOProperties                func(*IOStream) PropertiesID
+	sdlGetIOSize                      func(*IOStream) int64
+	sdlGetIOStatus                    func(*IOStream) IOStatus
+	sdlGetJoystickAxis                func(*Joystick, int32) int16
+	sdlGetJoystickAxisInitialState    func(*Joystick, int32, *int16) bool
+	sdlGetJoystickBall                func(*Joystick, int32, *int32, *int32) bool
+	sdlGetJoystickButton              func(*Joystick, int32) bool
+	sdlGetJoystickConnectionState     func(*Joystick) JoystickConnectionState
+	sdlGetJoystickFirmwareVersion     func(*Joystick) uint16
+	sdlGetJoystickFromID              func(JoystickID) *Joystick
+	sdlGetJoystickFromPlayerIndex     func(int32) *Joystick
+	sdlGetJoystickGUID                uintptr
+	sdlGetJoystickGUIDForID           uintptr
+	sdlGetJoystickGUIDInfo            uintptr
 	sdlGetJoystickHat                 func(*Joystick, int32) uint8
 	sdlGetJoystickID                  func(*Joystick) JoystickID
 	sdlGetJoystickName                func(*Joystick) string
@@ -420,8 +420,8 @@ var (
 	// sdlGetLogOutputFunction                  func(*LogOutputFunction, *unsafe.Pointer)
 	// sdlGetLogPriority                        func(int32) LogPriority
 	// sdlGetMasksForPixelFormat                func(PixelFormat, *int32, *uint32, *uint32, *uint32, *uint32) bool
-	// sdlGetMaxHapticEffects                   func(*Haptic) int32
-	// sdlGetMaxHapticEffectsPlaying            func(*Haptic) int32
+	sdlGetMaxHapticEffects        func(*Haptic) int32
+	sdlGetMaxHapticEffectsPlaying func(*Haptic) int32
 	// sdlGetMemoryFunctions                    func(*malloc_func, *calloc_func, *realloc_func, *free_func)
 	sdlGetMice           func(*int32) *MouseID
 	sdlGetModState       func() Keymod
@@ -430,22 +430,22 @@ var (
 	sdlGetMouseState     func(*float32, *float32) MouseButtonFlags
 	// sdlGetNaturalDisplayOrientation          func(DisplayID) DisplayOrientation
 	// sdlGetNumAllocations                     func() int32
-	sdlGetNumAudioDrivers  func() int32
-	sdlGetNumberProperty   func(PropertiesID, string, int64) int64
-	sdlGetNumCameraDrivers func() int32
-	// sdlGetNumGamepadTouchpadFingers          func(*Gamepad, int32) int32
-	// sdlGetNumGamepadTouchpads                func(*Gamepad) int32
-	sdlGetNumGPUDrivers func() int32
-	// sdlGetNumHapticAxes                      func(*Haptic) int32
-	sdlGetNumJoystickAxes    func(*Joystick) int32
-	sdlGetNumJoystickBalls   func(*Joystick) int32
-	sdlGetNumJoystickButtons func(*Joystick) int32
-	sdlGetNumJoystickHats    func(*Joystick) int32
+	sdlGetNumAudioDrivers           func() int32
+	sdlGetNumberProperty            func(PropertiesID, string, int64) int64
+	sdlGetNumCameraDrivers          func() int32
+	sdlGetNumGamepadTouchpadFingers func(*Gamepad, int32) int32
+	sdlGetNumGamepadTouchpads       func(*Gamepad) int32
+	sdlGetNumGPUDrivers             func() int32
+	sdlGetNumHapticAxes             func(*Haptic) int32
+	sdlGetNumJoystickAxes           func(*Joystick) int32
+	sdlGetNumJoystickBalls          func(*Joystick) int32
+	sdlGetNumJoystickButtons        func(*Joystick) int32
+	sdlGetNumJoystickHats           func(*Joystick) int32
 	// sdlGetNumLogicalCPUCores                 func() int32
 	sdlGetNumRenderDrivers func() int32
 	sdlGetNumVideoDrivers  func() int32
 	// sdlGetOriginalMemoryFunctions            func(*malloc_func, *calloc_func, *realloc_func, *free_func)
-	// sdlGetPathInfo                           func(string, *PathInfo) bool
+	sdlGetPathInfo             func(string, *pathInfo) bool
 	sdlGetPerformanceCounter   uintptr
 	sdlGetPerformanceFrequency uintptr
 	sdlGetPixelFormatDetails   func(PixelFormat) *PixelFormatDetails
@@ -458,12 +458,12 @@ var (
 	sdlGetPrefPath         func(*byte, *byte) *byte
 	sdlGetPrimaryDisplay   func() DisplayID
 	// sdlGetPrimarySelectionText               func() string
-	// sdlGetProcessInput                       func(*Process) *IOStream
-	// sdlGetProcessOutput                      func(*Process) *IOStream
-	// sdlGetProcessProperties                  func(*Process) PropertiesID
-	sdlGetPropertyType func(PropertiesID, string) PropertyType
-	// sdlGetRealGamepadType                    func(*Gamepad) GamepadType
-	// sdlGetRealGamepadTypeForID               func(JoystickID) GamepadType
+	sdlGetProcessInput                  func(*Process) *IOStream
+	sdlGetProcessOutput                 func(*Process) *IOStream
+	sdlGetProcessProperties             func(*Process) PropertiesID
+	sdlGetPropertyType                  func(PropertiesID, string) PropertyType
+	sdlGetRealGamepadType               func(*Gamepad) GamepadType
+	sdlGetRealGamepadTypeForID          func(JoystickID) GamepadType
 	sdlGetRectAndLineIntersection       func(*Rect, *int32, *int32, *int32, *int32) bool
 	sdlGetRectAndLineIntersectionFloat  func(*FRect, *float32, *float32, *float32, *float32) bool
 	sdlGetRectEnclosingPoints           func(*Point, int32, *Rect, *Rect) bool
@@ -502,32 +502,32 @@ var (
 	sdlGetScancodeFromName func(string) Scancode
 	sdlGetScancodeName     func(Scancode) string
 	// sdlGetSemaphoreValue                     func(*Semaphore) uint32
-	// sdlGetSensorData                         func(*Sensor, *float32, int32) bool
-	// sdlGetSensorFromID                       func(SensorID) *Sensor
-	// sdlGetSensorID                           func(*Sensor) SensorID
-	// sdlGetSensorName                         func(*Sensor) string
-	// sdlGetSensorNameForID                    func(SensorID) string
-	// sdlGetSensorNonPortableType              func(*Sensor) int32
-	// sdlGetSensorNonPortableTypeForID         func(SensorID) int32
-	// sdlGetSensorProperties                   func(*Sensor) PropertiesID
-	// sdlGetSensors                            func(*int32) *SensorID
-	// sdlGetSensorType                         func(*Sensor) SensorType
-	// sdlGetSensorTypeForID                    func(SensorID) SensorType
+	sdlGetSensorData                 func(*Sensor, *float32, int32) bool
+	sdlGetSensorFromID               func(SensorID) *Sensor
+	sdlGetSensorID                   func(*Sensor) SensorID
+	sdlGetSensorName                 func(*Sensor) string
+	sdlGetSensorNameForID            func(SensorID) string
+	sdlGetSensorNonPortableType      func(*Sensor) int32
+	sdlGetSensorNonPortableTypeForID func(SensorID) int32
+	sdlGetSensorProperties           func(*Sensor) PropertiesID
+	sdlGetSensors                    func(*int32) *SensorID
+	sdlGetSensorType                 func(*Sensor) SensorType
+	sdlGetSensorTypeForID            func(SensorID) SensorType
 	// sdlGetSilenceValueForFormat              func(AudioFormat) int32
 	// sdlGetSIMDAlignment                      func() uint64
-	// sdlGetStorageFileSize                    func(*Storage, string, *uint64) bool
-	// sdlGetStoragePathInfo                    func(*Storage, string, *PathInfo) bool
-	// sdlGetStorageSpaceRemaining              func(*Storage) uint64
-	sdlGetStringProperty    func(PropertiesID, string, string) string
-	sdlGetSurfaceAlphaMod   func(*Surface, *uint8) bool
-	sdlGetSurfaceBlendMode  func(*Surface, *BlendMode) bool
-	sdlGetSurfaceClipRect   func(*Surface, *Rect) bool
-	sdlGetSurfaceColorKey   func(*Surface, *uint32) bool
-	sdlGetSurfaceColorMod   func(*Surface, *uint8, *uint8, *uint8) bool
-	sdlGetSurfaceColorspace func(*Surface) Colorspace
-	sdlGetSurfaceImages     func(*Surface, *int32) **Surface
-	sdlGetSurfacePalette    func(*Surface) *Palette
-	sdlGetSurfaceProperties func(*Surface) PropertiesID
+	sdlGetStorageFileSize       func(*Storage, string, *uint64) bool
+	sdlGetStoragePathInfo       func(*Storage, string, *pathInfo) bool
+	sdlGetStorageSpaceRemaining func(*Storage) uint64
+	sdlGetStringProperty        func(PropertiesID, string, string) string
+	sdlGetSurfaceAlphaMod       func(*Surface, *uint8) bool
+	sdlGetSurfaceBlendMode      func(*Surface, *BlendMode) bool
+	sdlGetSurfaceClipRect       func(*Surface, *Rect) bool
+	sdlGetSurfaceColorKey       func(*Surface, *uint32) bool
+	sdlGetSurfaceColorMod       func(*Surface, *uint8, *uint8, *uint8) bool
+	sdlGetSurfaceColorspace     func(*Surface) Colorspace
+	sdlGetSurfaceImages         func(*Surface, *int32) **Surface
+	sdlGetSurfacePalette        func(*Surface) *Palette
+	sdlGetSurfaceProperties     func(*Surface) PropertiesID
 	// sdlGetSystemRAM                          func() int32
 	// sdlGetSystemTheme                        func() SystemTheme
 	sdlGetTextInputArea        func(*Window, *Rect, *int32) bool
@@ -545,10 +545,10 @@ var (
 	sdlGetTicks   uintptr
 	sdlGetTicksNS uintptr
 	// sdlGetTLS                                func(*TLSID) unsafe.Pointer
-	// sdlGetTouchDeviceName                    func(TouchID) string
-	// sdlGetTouchDevices                       func(*int32) *TouchID
-	// sdlGetTouchDeviceType                    func(TouchID) TouchDeviceType
-	// sdlGetTouchFingers                       func(TouchID, *int32) **Finger
+	sdlGetTouchDeviceName func(TouchID) string
+	sdlGetTouchDevices    func(*int32) *TouchID
+	sdlGetTouchDeviceType func(TouchID) TouchDeviceType
+	sdlGetTouchFingers    func(TouchID, *int32) **Finger
 	// sdlGetTrayEntries                        func(*TrayMenu, *int32) **TrayEntry
 	// sdlGetTrayEntryChecked                   func(*TrayEntry) bool
 	// sdlGetTrayEntryEnabled                   func(*TrayEntry) bool
@@ -558,7 +558,7 @@ var (
 	// sdlGetTrayMenuParentEntry                func(*TrayMenu) *TrayEntry
 	// sdlGetTrayMenuParentTray                 func(*TrayMenu) *Tray
 	// sdlGetTraySubmenu                        func(*TrayEntry) *TrayMenu
-	// sdlGetUserFolder                         func(Folder) string
+	sdlGetUserFolder  func(Folder) string
 	sdlGetVersion     func() int32
 	sdlGetVideoDriver func(int32) string
 	// sdlGetWindowAspectRatio                  func(*Window, *float32, *float32) bool
@@ -604,16 +604,16 @@ var (
 	sdlGLSetSwapInterval uintptr
 	sdlGLSwapWindow      uintptr
 	// sdlGL_UnloadLibrary                      func()
-	// sdlGlobDirectory                         func(string, string, GlobFlags, *int32) **byte
-	// sdlGlobStorageDirectory                  func(*Storage, string, string, GlobFlags, *int32) **byte
+	sdlGlobDirectory        func(string, *byte, GlobFlags, *int32) **byte
+	sdlGlobStorageDirectory func(*Storage, *byte, *byte, GlobFlags, *int32) **byte
 	// sdlGPUSupportsProperties                 func(PropertiesID) bool
 	// sdlGPUSupportsShaderFormats              func(GPUShaderFormat, string) bool
 	// sdlGPUTextureFormatTexelBlockSize        func(GPUTextureFormat) uint32
 	// sdlGPUTextureSupportsFormat              func(*GPUDevice, GPUTextureFormat, GPUTextureType, GPUTextureUsageFlags) bool
 	// sdlGPUTextureSupportsSampleCount         func(*GPUDevice, GPUTextureFormat, GPUSampleCount) bool
 	// sdlGUIDToString                          func(GUID, string, int32)
-	// sdlHapticEffectSupported                 func(*Haptic, *HapticEffect) bool
-	// sdlHapticRumbleSupported                 func(*Haptic) bool
+	sdlHapticEffectSupported func(*Haptic, *HapticEffect) bool
+	sdlHapticRumbleSupported func(*Haptic) bool
 	// sdlHasAltiVec                            func() bool
 	// sdlHasARMSIMD                            func() bool
 	// sdlHasAVX                                func() bool
@@ -624,7 +624,7 @@ var (
 	sdlHasEvent  func(EventType) bool
 	sdlHasEvents func(EventType, EventType) bool
 	// sdlHasExactlyOneBitSet32                 func(uint32) bool
-	// sdlHasGamepad                            func() bool
+	sdlHasGamepad  func() bool
 	sdlHasJoystick func() bool
 	sdlHasKeyboard func() bool
 	// sdlHasLASX                               func() bool
@@ -642,43 +642,43 @@ var (
 	// sdlHasSSE3                               func() bool
 	// sdlHasSSE41                              func() bool
 	// sdlHasSSE42                              func() bool
-	// sdlhid_ble_scan                          func(bool)
-	// sdlhid_close                             func(*hid_device) int32
-	// sdlhid_device_change_count               func() uint32
-	// sdlhid_enumerate                         func(uint16, uint16) *hid_device_info
-	// sdlhid_exit                              func() int32
-	// sdlhid_free_enumeration                  func(*hid_device_info)
-	// sdlhid_get_device_info                   func(*hid_device) *hid_device_info
-	// sdlhid_get_feature_report                func(*hid_device, *uint8, uint64) int32
-	// sdlhid_get_indexed_string                func(*hid_device, int32, *wchar_t, uint64) int32
-	// sdlhid_get_input_report                  func(*hid_device, *uint8, uint64) int32
-	// sdlhid_get_manufacturer_string           func(*hid_device, *wchar_t, uint64) int32
-	// sdlhid_get_product_string                func(*hid_device, *wchar_t, uint64) int32
-	// sdlhid_get_report_descriptor             func(*hid_device, *uint8, uint64) int32
-	// sdlhid_get_serial_number_string          func(*hid_device, *wchar_t, uint64) int32
-	// sdlhid_init                              func() int32
-	// sdlhid_open                              func(uint16, uint16, *wchar_t) *hid_device
-	// sdlhid_open_path                         func(string) *hid_device
-	// sdlhid_read                              func(*hid_device, *uint8, uint64) int32
-	// sdlhid_read_timeout                      func(*hid_device, *uint8, uint64, int32) int32
-	// sdlhid_send_feature_report               func(*hid_device, *uint8, uint64) int32
-	// sdlhid_set_nonblocking                   func(*hid_device, int32) int32
-	// sdlhid_write                             func(*hid_device, *uint8, uint64) int32
-	sdlHideCursor func() bool
-	sdlHideWindow func(*Window) bool
+	sdlHidBleScan               func(bool)
+	sdlHidClose                 func(*hidDevice) int32
+	sdlHidDeviceChangeCount     func() uint32
+	sdlHidEnumerate             func(uint16, uint16) *hidDeviceInfo
+	sdlHidExit                  func() int32
+	sdlHidFreeEnumeration       func(*hidDeviceInfo)
+	sdlHidGetDeviceInfo         func(*hidDevice) *hidDeviceInfo
+	sdlHidGetFeatureReport      func(*hidDevice, *uint8, uint64) int32
+	sdlHidGetIndexedString      func(*hidDevice, int32, unsafe.Pointer, uint64) int32
+	sdlHidGetInputReport        func(*hidDevice, *uint8, uint64) int32
+	sdlHidGetManufacturerString func(*hidDevice, unsafe.Pointer, uint64) int32
+	sdlHidGetProductString      func(*hidDevice, unsafe.Pointer, uint64) int32
+	sdlHidGetReportDescriptor   func(*hidDevice, *uint8, uint64) int32
+	sdlHidGetSerialNumberString func(*hidDevice, unsafe.Pointer, uint64) int32
+	sdlHidInit                  func() int32
+	sdlHidOpen                  func(uint16, uint16, unsafe.Pointer) *hidDevice
+	sdlHidOpenPath              func(string) *hidDevice
+	sdlHidRead                  func(*hidDevice, *uint8, uint64) int32
+	sdlHidReadTimeout           func(*hidDevice, *uint8, uint64, int32) int32
+	sdlHidSendFeatureReport     func(*hidDevice, *uint8, uint64) int32
+	sdlHidSetNonblocking        func(*hidDevice, int32) int32
+	sdlHidWrite                 func(*hidDevice, *uint8, uint64) int32
+	sdlHideCursor               func() bool
+	sdlHideWindow               func(*Window) bool
 	// sdliconv                                 func(iconv_t, **byte, *uint64, **byte, *uint64) uint64
 	// sdliconv_close                           func(iconv_t) int32
 	// sdliconv_open                            func(string, string) iconv_t
 	// sdliconv_string                          func(string, string, string, uint64) string
-	sdlInit func(InitFlags) bool
-	// sdlInitHapticRumble                      func(*Haptic) bool
+	sdlInit             func(InitFlags) bool
+	sdlInitHapticRumble func(*Haptic) bool
 	// sdlInitSubSystem                         func(InitFlags) bool
 	// sdlInsertGPUDebugLabel                   func(*GPUCommandBuffer, string)
 	// sdlInsertTrayEntryAt                     func(*TrayMenu, int32, string, TrayEntryFlags) *TrayEntry
-	sdlIOFromConstMem func([]byte, int) *IOStream
-	// sdlIOFromDynamicMem                      func() *IOStream
-	sdlIOFromFile func(string, string) *IOStream
-	sdlIOFromMem  func([]byte, int) *IOStream
+	sdlIOFromConstMem   func([]byte, int) *IOStream
+	sdlIOFromDynamicMem func() *IOStream
+	sdlIOFromFile       func(string, string) *IOStream
+	sdlIOFromMem        func([]byte, int) *IOStream
 	// sdlIOprintf                              func(*IOStream, string) uint64
 	// sdlIOvprintf                             func(*IOStream, string, va_list) uint64
 	// sdlisalnum                               func(int32) int32
@@ -688,15 +688,15 @@ var (
 	// sdlisblank                               func(int32) int32
 	// sdliscntrl                               func(int32) int32
 	// sdlisdigit                               func(int32) int32
-	// sdlIsGamepad                             func(JoystickID) bool
+	sdlIsGamepad func(JoystickID) bool
 	// sdlisgraph                               func(int32) int32
 	// sdlisinf                                 func(float64) int32
 	// sdlisinff                                func(float32) int32
-	// sdlIsJoystickHaptic                      func(*Joystick) bool
-	// sdlIsJoystickVirtual func(JoystickID) bool
+	sdlIsJoystickHaptic  func(*Joystick) bool
+	sdlIsJoystickVirtual func(JoystickID) bool
 	// sdlislower                               func(int32) int32
-	sdlIsMainThread func() bool
-	// sdlIsMouseHaptic                         func() bool
+	sdlIsMainThread  func() bool
+	sdlIsMouseHaptic func() bool
 	// sdlisnan                                 func(float64) int32
 	// sdlisnanf                                func(float32) int32
 	// sdlisprint                               func(int32) int32
@@ -709,13 +709,13 @@ var (
 	// sdlitoa                                  func(int32, string, int32) string
 	sdlJoystickConnected     func(*Joystick) bool
 	sdlJoystickEventsEnabled func() bool
-	// sdlKillProcess                           func(*Process, bool) bool
+	sdlKillProcess           func(*Process, bool) bool
 	// sdllltoa                                 func(int64, string, int32) string
-	sdlLoadBMP   func(string) *Surface
-	sdlLoadBMPIO func(*IOStream, bool) *Surface
-	sdlLoadFile  func(string, *uint64) unsafe.Pointer
-	// sdlLoadFile_IO                           func(*IOStream, *uint64, bool) unsafe.Pointer
-	// sdlLoadFileAsync                         func(string, *AsyncIOQueue, unsafe.Pointer) bool
+	sdlLoadBMP       func(string) *Surface
+	sdlLoadBMPIO     func(*IOStream, bool) *Surface
+	sdlLoadFile      func(string, *uint64) unsafe.Pointer
+	sdlLoadFile_IO   func(*IOStream, *uint64, bool) unsafe.Pointer
+	sdlLoadFileAsync func(string, *AsyncIOQueue, uintptr) bool
 	// sdlLoadFunction                          func(*SharedObject, string) FunctionPointer
 	// sdlLoadObject                            func(string) *SharedObject
 	// sdlLoadWAV                               func(string, *AudioSpec, **uint8, *uint32) bool
@@ -748,7 +748,7 @@ var (
 	// sdllroundf                               func(float32) int64
 	// sdlltoa                                  func(int64, string, int32) string
 	// sdlmain                                  func(int32, **byte) int32
-	// sdlmalloc                                func(uint64) unsafe.Pointer
+	sdlmalloc               func(uint64) unsafe.Pointer
 	sdlMapGPUTransferBuffer func(*GPUDevice, *GPUTransferBuffer, bool) unsafe.Pointer
 	sdlMapRGB               func(*PixelFormatDetails, *Palette, uint8, uint8, uint8) uint32
 	// sdlMapRGBA                               func(*PixelFormatDetails, *Palette, uint8, uint8, uint8, uint8) uint32
@@ -778,27 +778,27 @@ var (
 	// sdlOnApplicationWillEnterForeground      func()
 	// sdlOnApplicationWillTerminate            func()
 	// sdlOpenAudioDevice                       func(AudioDeviceID, *AudioSpec) AudioDeviceID
-	sdlOpenAudioDeviceStream func(AudioDeviceID, *AudioSpec, AudioStreamCallback, unsafe.Pointer) *AudioStream
-	sdlOpenCamera            func(CameraID, *CameraSpec) *Camera
-	// sdlOpenFileStorage                       func(string) *Storage
-	sdlOpenGamepad func(JoystickID) *Gamepad
-	// sdlOpenHaptic                            func(HapticID) *Haptic
-	// sdlOpenHapticFromJoystick                func(*Joystick) *Haptic
-	// sdlOpenHapticFromMouse                   func() *Haptic
-	// sdlOpenIO                                func(*IOStreamInterface, unsafe.Pointer) *IOStream
-	sdlOpenJoystick func(JoystickID) *Joystick
-	// sdlOpenSensor                            func(SensorID) *Sensor
-	// sdlOpenStorage                           func(*StorageInterface, unsafe.Pointer) *Storage
-	// sdlOpenTitleStorage                      func(string, PropertiesID) *Storage
-	sdlOpenURL func(string) bool
-	// sdlOpenUserStorage                       func(string, string, PropertiesID) *Storage
+	sdlOpenAudioDeviceStream  func(AudioDeviceID, *AudioSpec, AudioStreamCallback, unsafe.Pointer) *AudioStream
+	sdlOpenCamera             func(CameraID, *CameraSpec) *Camera
+	sdlOpenFileStorage        func(string) *Storage
+	sdlOpenGamepad            func(JoystickID) *Gamepad
+	sdlOpenHaptic             func(HapticID) *Haptic
+	sdlOpenHapticFromJoystick func(*Joystick) *Haptic
+	sdlOpenHapticFromMouse    func() *Haptic
+	sdlOpenIO                 func(*ioStreamInterface, uintptr) *IOStream
+	sdlOpenJoystick           func(JoystickID) *Joystick
+	sdlOpenSensor             func(SensorID) *Sensor
+	sdlOpenStorage            func(*storageInterface, uintptr) *Storage
+	sdlOpenTitleStorage       func(*byte, PropertiesID) *Storage
+	sdlOpenURL                func(string) bool
+	sdlOpenUserStorage        func(string, string, PropertiesID) *Storage
 	// sdlOutOfMemory                           func() bool
 	// sdlPauseAudioDevice                      func(AudioDeviceID) bool
 	sdlPauseAudioStreamDevice uintptr
-	// sdlPauseHaptic                           func(*Haptic) bool
-	sdlPeepEvents func(*Event, int32, EventAction, EventType, EventType) int32
-	// sdlPlayHapticRumble                      func(*Haptic, float32, uint32) bool
-	sdlPollEvent uintptr
+	sdlPauseHaptic            func(*Haptic) bool
+	sdlPeepEvents             func(*Event, int32, EventAction, EventType, EventType) int32
+	sdlPlayHapticRumble       func(*Haptic, float32, uint32) bool
+	sdlPollEvent              uintptr
 	// sdlPopGPUDebugGroup                      func(*GPUCommandBuffer)
 	// sdlpow                                   func(float64, float64) float64
 	// sdlpowf                                  func(float32, float32) float32
@@ -823,9 +823,9 @@ var (
 	// sdlrand_r                                func(*uint64, int32) int32
 	// sdlrandf                                 func() float32
 	// sdlrandf_r                               func(*uint64) float32
-	// sdlReadAsyncIO                           func(*AsyncIO, unsafe.Pointer, uint64, uint64, *AsyncIOQueue, unsafe.Pointer) bool
-	// sdlReadIO                                func(*IOStream, unsafe.Pointer, uint64) uint64
-	// sdlReadProcess                           func(*Process, *uint64, *int32) unsafe.Pointer
+	sdlReadAsyncIO func(*AsyncIO, unsafe.Pointer, uint64, uint64, *AsyncIOQueue, uintptr) bool
+	sdlReadIO      func(*IOStream, unsafe.Pointer, uint64) uint64
+	sdlReadProcess func(*Process, *uint64, *int32) unsafe.Pointer
 	// sdlReadS16BE                             func(*IOStream, *int16) bool
 	// sdlReadS16LE                             func(*IOStream, *int16) bool
 	// sdlReadS32BE                             func(*IOStream, *int32) bool
@@ -833,7 +833,7 @@ var (
 	// sdlReadS64BE                             func(*IOStream, *int64) bool
 	// sdlReadS64LE                             func(*IOStream, *int64) bool
 	// sdlReadS8                                func(*IOStream, *int8) bool
-	// sdlReadStorageFile                       func(*Storage, string, unsafe.Pointer, uint64) bool
+	sdlReadStorageFile func(*Storage, string, unsafe.Pointer, uint64) bool
 	// sdlReadSurfacePixel                      func(*Surface, int32, int32, *uint8, *uint8, *uint8, *uint8) bool
 	// sdlReadSurfacePixelFloat                 func(*Surface, int32, int32, *float32, *float32, *float32, *float32) bool
 	// sdlReadU16BE                             func(*IOStream, *uint16) bool
@@ -848,23 +848,23 @@ var (
 	sdlReleaseCameraFrame func(*Camera, *Surface)
 	sdlReleaseGPUBuffer   func(*GPUDevice, *GPUBuffer)
 	// sdlReleaseGPUComputePipeline             func(*GPUDevice, *GPUComputePipeline)
-	sdlReleaseGPUFence            func(*GPUDevice, *GPUFence)
-	sdlReleaseGPUGraphicsPipeline func(*GPUDevice, *GPUGraphicsPipeline)
-	sdlReleaseGPUSampler          func(*GPUDevice, *GPUSampler)
-	sdlReleaseGPUShader           func(*GPUDevice, *GPUShader)
-	sdlReleaseGPUTexture          func(*GPUDevice, *GPUTexture)
-	sdlReleaseGPUTransferBuffer   func(*GPUDevice, *GPUTransferBuffer)
-	sdlReleaseWindowFromGPUDevice func(*GPUDevice, *Window)
-	// sdlReloadGamepadMappings                 func() bool
-	sdlRemoveEventWatch   func(EventFilter, unsafe.Pointer)
-	sdlRemoveHintCallback func(string, HintCallback, unsafe.Pointer)
-	// sdlRemovePath                            func(string) bool
-	// sdlRemoveStoragePath                     func(*Storage, string) bool
+	sdlReleaseGPUFence              func(*GPUDevice, *GPUFence)
+	sdlReleaseGPUGraphicsPipeline   func(*GPUDevice, *GPUGraphicsPipeline)
+	sdlReleaseGPUSampler            func(*GPUDevice, *GPUSampler)
+	sdlReleaseGPUShader             func(*GPUDevice, *GPUShader)
+	sdlReleaseGPUTexture            func(*GPUDevice, *GPUTexture)
+	sdlReleaseGPUTransferBuffer     func(*GPUDevice, *GPUTransferBuffer)
+	sdlReleaseWindowFromGPUDevice   func(*GPUDevice, *Window)
+	sdlReloadGamepadMappings        func() bool
+	sdlRemoveEventWatch             func(EventFilter, unsafe.Pointer)
+	sdlRemoveHintCallback           func(string, HintCallback, unsafe.Pointer)
+	sdlRemovePath                   func(string) bool
+	sdlRemoveStoragePath            func(*Storage, string) bool
 	sdlRemoveSurfaceAlternateImages func(*Surface)
 	// sdlRemoveTimer                           func(TimerID) bool
 	// sdlRemoveTrayEntry                       func(*TrayEntry)
-	// sdlRenamePath                            func(string, string) bool
-	// sdlRenameStoragePath                     func(*Storage, string, string) bool
+	sdlRenamePath                  func(string, string) bool
+	sdlRenameStoragePath           func(*Storage, string, string) bool
 	sdlRenderClear                 uintptr
 	sdlRenderClipEnabled           func(*Renderer) bool
 	sdlRenderCoordinatesFromWindow func(*Renderer, float32, float32, *float32, *float32) bool
@@ -898,29 +898,29 @@ var (
 	sdlRestoreWindow      func(*Window) bool
 	// sdlResumeAudioDevice                     func(AudioDeviceID) bool
 	sdlResumeAudioStreamDevice uintptr
-	// sdlResumeHaptic                          func(*Haptic) bool
+	sdlResumeHaptic            func(*Haptic) bool
 	// sdlround                                 func(float64) float64
 	// sdlroundf                                func(float32) float32
-	// sdlRumbleGamepad                         func(*Gamepad, uint16, uint16, uint32) bool
-	// sdlRumbleGamepadTriggers                 func(*Gamepad, uint16, uint16, uint32) bool
+	sdlRumbleGamepad          func(*Gamepad, uint16, uint16, uint32) bool
+	sdlRumbleGamepadTriggers  func(*Gamepad, uint16, uint16, uint32) bool
 	sdlRumbleJoystick         func(*Joystick, uint16, uint16, uint32) bool
 	sdlRumbleJoystickTriggers func(*Joystick, uint16, uint16, uint32) bool
 	// sdlRunApp                                func(int32, **byte, main_func, unsafe.Pointer) int32
-	// sdlRunHapticEffect                       func(*Haptic, int32, uint32) bool
+	sdlRunHapticEffect func(*Haptic, int32, uint32) bool
 	// sdlRunOnMainThread                       func(MainThreadCallback, unsafe.Pointer, bool) bool
-	sdlSaveBMP   func(*Surface, string) bool
-	sdlSaveBMPIO func(*Surface, *IOStream, bool) bool
-	// sdlSaveFile                              func(string, unsafe.Pointer, uint64) bool
-	// sdlSaveFile_IO                           func(*IOStream, unsafe.Pointer, uint64, bool) bool
+	sdlSaveBMP     func(*Surface, string) bool
+	sdlSaveBMPIO   func(*Surface, *IOStream, bool) bool
+	sdlSaveFile    func(string, unsafe.Pointer, uint64) bool
+	sdlSaveFile_IO func(*IOStream, unsafe.Pointer, uint64, bool) bool
 	// sdlscalbn                                func(float64, int32) float64
 	// sdlscalbnf                               func(float32, int32) float32
 	sdlScaleSurface        func(*Surface, int32, int32, ScaleMode) *Surface
 	sdlScreenKeyboardShown func(*Window) bool
 	// sdlScreenSaverEnabled                    func() bool
-	// sdlSeekIO                                func(*IOStream, int64, IOWhence) int64
-	// sdlSendGamepadEffect                     func(*Gamepad, unsafe.Pointer, int32) bool
-	sdlSendJoystickEffect func(*Joystick, unsafe.Pointer, int32) bool
-	// sdlSendJoystickVirtualSensorData func(*Joystick, SensorType, uint64, *float32, int32) bool
+	sdlSeekIO                        func(*IOStream, int64, IOWhence) int64
+	sdlSendGamepadEffect             func(*Gamepad, unsafe.Pointer, int32) bool
+	sdlSendJoystickEffect            func(*Joystick, unsafe.Pointer, int32) bool
+	sdlSendJoystickVirtualSensorData func(*Joystick, SensorType, uint64, *float32, int32) bool
 	// sdlSetAppMetadata                        func(string, string, string) bool
 	// sdlSetAppMetadataProperty                func(string, string) bool
 	// sdlSetAssertionHandler                   func(AssertionHandler, unsafe.Pointer)
@@ -942,17 +942,17 @@ var (
 	// sdlSetCurrentThreadPriority              func(ThreadPriority) bool
 	sdlSetCursor func(*Cursor) bool
 	// sdlsetenv_unsafe                         func(string, string, int32) int32
-	// sdlSetEnvironmentVariable                func(*Environment, string, string, bool) bool
-	sdlSetError func(string) bool
+	sdlSetEnvironmentVariable func(*Environment, string, string, bool) bool
+	sdlSetError               func(string) bool
 	// sdlSetErrorV                             func(string, va_list) bool
-	sdlSetEventEnabled  func(EventType, bool)
-	sdlSetEventFilter   func(EventFilter, unsafe.Pointer)
-	sdlSetFloatProperty func(PropertiesID, string, float32) bool
-	// sdlSetGamepadEventsEnabled               func(bool)
-	// sdlSetGamepadLED                         func(*Gamepad, uint8, uint8, uint8) bool
-	// sdlSetGamepadMapping                     func(JoystickID, string) bool
-	// sdlSetGamepadPlayerIndex                 func(*Gamepad, int32) bool
-	// sdlSetGamepadSensorEnabled               func(*Gamepad, SensorType, bool) bool
+	sdlSetEventEnabled         func(EventType, bool)
+	sdlSetEventFilter          func(EventFilter, unsafe.Pointer)
+	sdlSetFloatProperty        func(PropertiesID, string, float32) bool
+	sdlSetGamepadEventsEnabled func(bool)
+	sdlSetGamepadLED           func(*Gamepad, uint8, uint8, uint8) bool
+	sdlSetGamepadMapping       func(JoystickID, *byte) bool
+	sdlSetGamepadPlayerIndex   func(*Gamepad, int32) bool
+	sdlSetGamepadSensorEnabled func(*Gamepad, SensorType, bool) bool
 	// sdlSetGPUAllowedFramesInFlight           func(*GPUDevice, uint32) bool
 	// sdlSetGPUBlendConstants                  func(*GPURenderPass, FColor)
 	sdlSetGPUBufferName func(*GPUDevice, *GPUBuffer, string)
@@ -960,20 +960,20 @@ var (
 	// sdlSetGPUStencilReference                func(*GPURenderPass, uint8)
 	sdlSetGPUSwapchainParameters func(*GPUDevice, *Window, GPUSwapchainComposition, GPUPresentMode) bool
 	// sdlSetGPUTextureName                     func(*GPUDevice, *GPUTexture, string)
-	sdlSetGPUViewport func(*GPURenderPass, *GPUViewport)
-	// sdlSetHapticAutocenter                   func(*Haptic, int32) bool
-	// sdlSetHapticGain                         func(*Haptic, int32) bool
+	sdlSetGPUViewport      func(*GPURenderPass, *GPUViewport)
+	sdlSetHapticAutocenter func(*Haptic, int32) bool
+	sdlSetHapticGain       func(*Haptic, int32) bool
 	sdlSetHint             func(string, string) bool
 	sdlSetHintWithPriority func(string, string, HintPriority) bool
 	// sdlSetInitialized                        func(*InitState, bool)
-	sdlSetJoystickEventsEnabled func(bool)
-	sdlSetJoystickLED           func(*Joystick, uint8, uint8, uint8) bool
-	sdlSetJoystickPlayerIndex   func(*Joystick, int32) bool
-	// sdlSetJoystickVirtualAxis                func(*Joystick, int32, int16) bool
-	// sdlSetJoystickVirtualBall                func(*Joystick, int32, int16, int16) bool
-	// sdlSetJoystickVirtualButton              func(*Joystick, int32, bool) bool
-	// sdlSetJoystickVirtualHat                 func(*Joystick, int32, uint8) bool
-	// sdlSetJoystickVirtualTouchpad            func(*Joystick, int32, int32, bool, float32, float32, float32) bool
+	sdlSetJoystickEventsEnabled   func(bool)
+	sdlSetJoystickLED             func(*Joystick, uint8, uint8, uint8) bool
+	sdlSetJoystickPlayerIndex     func(*Joystick, int32) bool
+	sdlSetJoystickVirtualAxis     func(*Joystick, int32, int16) bool
+	sdlSetJoystickVirtualBall     func(*Joystick, int32, int16, int16) bool
+	sdlSetJoystickVirtualButton   func(*Joystick, int32, bool) bool
+	sdlSetJoystickVirtualHat      func(*Joystick, int32, uint8) bool
+	sdlSetJoystickVirtualTouchpad func(*Joystick, int32, int32, bool, float32, float32, float32) bool
 	// sdlSetLinuxThreadPriority                func(int64, int32) bool
 	// sdlSetLinuxThreadPriorityAndPolicy       func(int64, int32, int32) bool
 	// sdlSetLogOutputFunction                  func(LogOutputFunction, unsafe.Pointer)
@@ -1057,7 +1057,7 @@ var (
 	sdlShowSimpleMessageBox         func(MessageBoxFlags, string, string, *Window) bool
 	sdlShowWindow                   func(*Window) bool
 	// sdlShowWindowSystemMenu                  func(*Window, int32, int32) bool
-	// sdlSignalAsyncIOQueue                    func(*AsyncIOQueue)
+	sdlSignalAsyncIOQueue func(*AsyncIOQueue)
 	// sdlSignalCondition                       func(*Condition)
 	// sdlSignalSemaphore                       func(*Semaphore)
 	// sdlsin                                   func(float64) float64
@@ -1075,11 +1075,11 @@ var (
 	sdlStartTextInputWithProperties func(*Window, PropertiesID) bool
 	// sdlStepBackUTF8                          func(string, **byte) uint32
 	// sdlStepUTF8                              func(**byte, *uint64) uint32
-	// sdlStopHapticEffect                      func(*Haptic, int32) bool
-	// sdlStopHapticEffects                     func(*Haptic) bool
-	// sdlStopHapticRumble                      func(*Haptic) bool
-	sdlStopTextInput func(*Window) bool
-	// sdlStorageReady                          func(*Storage) bool
+	sdlStopHapticEffect  func(*Haptic, int32) bool
+	sdlStopHapticEffects func(*Haptic) bool
+	sdlStopHapticRumble  func(*Haptic) bool
+	sdlStopTextInput     func(*Window) bool
+	sdlStorageReady      func(*Storage) bool
 	// sdlstrcasecmp                            func(string, string) int32
 	// sdlstrcasestr                            func(string, string) string
 	// sdlstrchr                                func(string, int32) string
@@ -1116,7 +1116,7 @@ var (
 	sdlSyncWindow func(*Window) bool
 	// sdltan                                   func(float64) float64
 	// sdltanf                                  func(float32) float32
-	// sdlTellIO                                func(*IOStream) int64
+	sdlTellIO          func(*IOStream) int64
 	sdlTextInputActive func(*Window) bool
 	// sdlTimeFromWindows                       func(uint32, uint32) Time
 	// sdlTimeToDateTime                        func(Time, *DateTime, bool) bool
@@ -1147,14 +1147,14 @@ var (
 	sdlUnlockTexture          func(*Texture)
 	sdlUnmapGPUTransferBuffer func(*GPUDevice, *GPUTransferBuffer)
 	// sdlunsetenv_unsafe                       func(string) int32
-	// sdlUnsetEnvironmentVariable              func(*Environment, string) bool
-	// sdlUpdateGamepads                        func()
-	// sdlUpdateHapticEffect                    func(*Haptic, int32, *HapticEffect) bool
-	sdlUpdateJoysticks func()
-	sdlUpdateNVTexture uintptr
-	// sdlUpdateSensors                         func()
-	sdlUpdateTexture       uintptr
-	sdlUpdateWindowSurface func(*Window) bool
+	sdlUnsetEnvironmentVariable func(*Environment, string) bool
+	sdlUpdateGamepads           func()
+	sdlUpdateHapticEffect       func(*Haptic, int32, *HapticEffect) bool
+	sdlUpdateJoysticks          func()
+	sdlUpdateNVTexture          uintptr
+	sdlUpdateSensors            func()
+	sdlUpdateTexture            uintptr
+	sdlUpdateWindowSurface      func(*Window) bool
 	// sdlUpdateWindowSurfaceRects              func(*Window, *Rect, int32) bool
 	sdlUpdateYUVTexture   uintptr
 	sdlUploadToGPUBuffer  func(*GPUCopyPass, *GPUTransferBufferLocation, *GPUBufferRegion, bool)
@@ -1167,7 +1167,7 @@ var (
 	// sdlvsscanf                               func(string, string, va_list) int32
 	// sdlvswprintf                             func(*wchar_t, uint64, *wchar_t, va_list) int32
 	sdlWaitAndAcquireGPUSwapchainTexture func(*GPUCommandBuffer, *Window, **GPUTexture, *uint32, *uint32) bool
-	// sdlWaitAsyncIOResult                     func(*AsyncIOQueue, *AsyncIOOutcome, int32) bool
+	sdlWaitAsyncIOResult                 func(*AsyncIOQueue, *AsyncIOOutcome, int32) bool
 	// sdlWaitCondition                         func(*Condition, *Mutex)
 	// sdlWaitConditionTimeout                  func(*Condition, *Mutex, int32) bool
 	sdlWaitEvent           func(*Event) bool
@@ -1175,7 +1175,7 @@ var (
 	sdlWaitForGPUFences    func(*GPUDevice, bool, **GPUFence, uint32) bool
 	sdlWaitForGPUIdle      func(*GPUDevice) bool
 	sdlWaitForGPUSwapchain func(*GPUDevice, *Window) bool
-	// sdlWaitProcess                           func(*Process, bool, *int32) bool
+	sdlWaitProcess         func(*Process, bool, *int32) bool
 	// sdlWaitSemaphore                         func(*Semaphore)
 	// sdlWaitSemaphoreTimeout                  func(*Semaphore, int32) bool
 	// sdlWaitThread                            func(*Thread, *int32)
@@ -1197,8 +1197,8 @@ var (
 	// sdlWindowHasSurface                      func(*Window) bool
 	sdlWindowSupportsGPUPresentMode func(*GPUDevice, *Window, GPUPresentMode) bool
 	// sdlWindowSupportsGPUSwapchainComposition func(*GPUDevice, *Window, GPUSwapchainComposition) bool
-	// sdlWriteAsyncIO                          func(*AsyncIO, unsafe.Pointer, uint64, uint64, *AsyncIOQueue, unsafe.Pointer) bool
-	// sdlWriteIO                               func(*IOStream, unsafe.Pointer, uint64) uint64
+	sdlWriteAsyncIO func(*AsyncIO, unsafe.Pointer, uint64, uint64, *AsyncIOQueue, uintptr) bool
+	sdlWriteIO      func(*IOStream, unsafe.Pointer, uint64) uint64
 	// sdlWriteS16BE                            func(*IOStream, int16) bool
 	// sdlWriteS16LE                            func(*IOStream, int16) bool
 	// sdlWriteS32BE                            func(*IOStream, int32) bool
@@ -1206,7 +1206,7 @@ var (
 	// sdlWriteS64BE                            func(*IOStream, int64) bool
 	// sdlWriteS64LE                            func(*IOStream, int64) bool
 	// sdlWriteS8                               func(*IOStream, int8) bool
-	// sdlWriteStorageFile                      func(*Storage, string, unsafe.Pointer, uint64) bool
+	sdlWriteStorageFile func(*Storage, string, unsafe.Pointer, uint64) bool
 	// sdlWriteSurfacePixel                     func(*Surface, int32, int32, uint8, uint8, uint8, uint8) bool
 	// sdlWriteSurfacePixelFloat                func(*Surface, int32, int32, float32, float32, float32, float32) bool
 	// sdlWriteU16BE                            func(*IOStream, uint16) bool
@@ -1244,9 +1244,9 @@ func init() {
 	purego.RegisterLibFunc(&sdlAcquireGPUSwapchainTexture, lib, "SDL_AcquireGPUSwapchainTexture")
 	// purego.RegisterLibFunc(&sdlAddAtomicInt, lib, "SDL_AddAtomicInt")
 	purego.RegisterLibFunc(&sdlAddEventWatch, lib, "SDL_AddEventWatch")
-	// purego.RegisterLibFunc(&sdlAddGamepadMapping, lib, "SDL_AddGamepadMapping")
-	// purego.RegisterLibFunc(&sdlAddGamepadMappingsFromFile, lib, "SDL_AddGamepadMappingsFromFile")
-	// purego.RegisterLibFunc(&sdlAddGamepadMappingsFromIO, lib, "SDL_AddGamepadMappingsFromIO")
+	purego.RegisterLibFunc(&sdlAddGamepadMapping, lib, "SDL_AddGamepadMapping")
+	purego.RegisterLibFunc(&sdlAddGamepadMappingsFromFile, lib, "SDL_AddGamepadMappingsFromFile")
+	purego.RegisterLibFunc(&sdlAddGamepadMappingsFromIO, lib, "SDL_AddGamepadMappingsFromIO")
 	purego.RegisterLibFunc(&sdlAddHintCallback, lib, "SDL_AddHintCallback")
 	purego.RegisterLibFunc(&sdlAddSurfaceAlternateImage, lib, "SDL_AddSurfaceAlternateImage")
 	// purego.RegisterLibFunc(&sdlAddTimer, lib, "SDL_AddTimer")
@@ -1257,14 +1257,14 @@ func init() {
 	// purego.RegisterLibFunc(&sdlasin, lib, "SDL_asin")
 	// purego.RegisterLibFunc(&sdlasinf, lib, "SDL_asinf")
 	// purego.RegisterLibFunc(&sdlasprintf, lib, "SDL_asprintf")
-	// purego.RegisterLibFunc(&sdlAsyncIOFromFile, lib, "SDL_AsyncIOFromFile")
+	purego.RegisterLibFunc(&sdlAsyncIOFromFile, lib, "SDL_AsyncIOFromFile")
 	// purego.RegisterLibFunc(&sdlatan, lib, "SDL_atan")
 	// purego.RegisterLibFunc(&sdlatan2, lib, "SDL_atan2")
 	// purego.RegisterLibFunc(&sdlatan2f, lib, "SDL_atan2f")
 	// purego.RegisterLibFunc(&sdlatanf, lib, "SDL_atanf")
 	// purego.RegisterLibFunc(&sdlatof, lib, "SDL_atof")
 	// purego.RegisterLibFunc(&sdlatoi, lib, "SDL_atoi")
-	// purego.RegisterLibFunc(&sdlAttachVirtualJoystick, lib, "SDL_AttachVirtualJoystick")
+	purego.RegisterLibFunc(&sdlAttachVirtualJoystick, lib, "SDL_AttachVirtualJoystick")
 	// purego.RegisterLibFunc(&sdlAudioDevicePaused, lib, "SDL_AudioDevicePaused")
 	sdlAudioStreamDevicePaused = shared.Get(lib, "SDL_AudioStreamDevicePaused")
 	// purego.RegisterLibFunc(&sdlBeginGPUComputePass, lib, "SDL_BeginGPUComputePass")
@@ -1311,15 +1311,15 @@ func init() {
 	purego.RegisterLibFunc(&sdlClearProperty, lib, "SDL_ClearProperty")
 	// purego.RegisterLibFunc(&sdlClearSurface, lib, "SDL_ClearSurface")
 	// purego.RegisterLibFunc(&sdlClickTrayEntry, lib, "SDL_ClickTrayEntry")
-	// purego.RegisterLibFunc(&sdlCloseAsyncIO, lib, "SDL_CloseAsyncIO")
+	purego.RegisterLibFunc(&sdlCloseAsyncIO, lib, "SDL_CloseAsyncIO")
 	// purego.RegisterLibFunc(&sdlCloseAudioDevice, lib, "SDL_CloseAudioDevice")
 	purego.RegisterLibFunc(&sdlCloseCamera, lib, "SDL_CloseCamera")
 	purego.RegisterLibFunc(&sdlCloseGamepad, lib, "SDL_CloseGamepad")
-	// purego.RegisterLibFunc(&sdlCloseHaptic, lib, "SDL_CloseHaptic")
+	purego.RegisterLibFunc(&sdlCloseHaptic, lib, "SDL_CloseHaptic")
 	purego.RegisterLibFunc(&sdlCloseIO, lib, "SDL_CloseIO")
 	purego.RegisterLibFunc(&sdlCloseJoystick, lib, "SDL_CloseJoystick")
-	// purego.RegisterLibFunc(&sdlCloseSensor, lib, "SDL_CloseSensor")
-	// purego.RegisterLibFunc(&sdlCloseStorage, lib, "SDL_CloseStorage")
+	purego.RegisterLibFunc(&sdlCloseSensor, lib, "SDL_CloseSensor")
+	purego.RegisterLibFunc(&sdlCloseStorage, lib, "SDL_CloseStorage")
 	// purego.RegisterLibFunc(&sdlCompareAndSwapAtomicInt, lib, "SDL_CompareAndSwapAtomicInt")
 	// purego.RegisterLibFunc(&sdlCompareAndSwapAtomicPointer, lib, "SDL_CompareAndSwapAtomicPointer")
 	// purego.RegisterLibFunc(&sdlCompareAndSwapAtomicU32, lib, "SDL_CompareAndSwapAtomicU32")
@@ -1330,24 +1330,24 @@ func init() {
 	purego.RegisterLibFunc(&sdlConvertPixelsAndColorspace, lib, "SDL_ConvertPixelsAndColorspace")
 	purego.RegisterLibFunc(&sdlConvertSurface, lib, "SDL_ConvertSurface")
 	purego.RegisterLibFunc(&sdlConvertSurfaceAndColorspace, lib, "SDL_ConvertSurfaceAndColorspace")
-	// purego.RegisterLibFunc(&sdlCopyFile, lib, "SDL_CopyFile")
+	purego.RegisterLibFunc(&sdlCopyFile, lib, "SDL_CopyFile")
 	// purego.RegisterLibFunc(&sdlCopyGPUBufferToBuffer, lib, "SDL_CopyGPUBufferToBuffer")
 	// purego.RegisterLibFunc(&sdlCopyGPUTextureToTexture, lib, "SDL_CopyGPUTextureToTexture")
 	purego.RegisterLibFunc(&sdlCopyProperties, lib, "SDL_CopyProperties")
 	// purego.RegisterLibFunc(&sdlcopysign, lib, "SDL_copysign")
 	// purego.RegisterLibFunc(&sdlcopysignf, lib, "SDL_copysignf")
-	// purego.RegisterLibFunc(&sdlCopyStorageFile, lib, "SDL_CopyStorageFile")
+	purego.RegisterLibFunc(&sdlCopyStorageFile, lib, "SDL_CopyStorageFile")
 	// purego.RegisterLibFunc(&sdlcos, lib, "SDL_cos")
 	// purego.RegisterLibFunc(&sdlcosf, lib, "SDL_cosf")
 	// purego.RegisterLibFunc(&sdlcrc16, lib, "SDL_crc16")
 	// purego.RegisterLibFunc(&sdlcrc32, lib, "SDL_crc32")
-	// purego.RegisterLibFunc(&sdlCreateAsyncIOQueue, lib, "SDL_CreateAsyncIOQueue")
+	purego.RegisterLibFunc(&sdlCreateAsyncIOQueue, lib, "SDL_CreateAsyncIOQueue")
 	// purego.RegisterLibFunc(&sdlCreateAudioStream, lib, "SDL_CreateAudioStream")
 	purego.RegisterLibFunc(&sdlCreateColorCursor, lib, "SDL_CreateColorCursor")
 	// purego.RegisterLibFunc(&sdlCreateCondition, lib, "SDL_CreateCondition")
 	purego.RegisterLibFunc(&sdlCreateCursor, lib, "SDL_CreateCursor")
-	// purego.RegisterLibFunc(&sdlCreateDirectory, lib, "SDL_CreateDirectory")
-	// purego.RegisterLibFunc(&sdlCreateEnvironment, lib, "SDL_CreateEnvironment")
+	purego.RegisterLibFunc(&sdlCreateDirectory, lib, "SDL_CreateDirectory")
+	purego.RegisterLibFunc(&sdlCreateEnvironment, lib, "SDL_CreateEnvironment")
 	purego.RegisterLibFunc(&sdlCreateGPUBuffer, lib, "SDL_CreateGPUBuffer")
 	// purego.RegisterLibFunc(&sdlCreateGPUComputePipeline, lib, "SDL_CreateGPUComputePipeline")
 	purego.RegisterLibFunc(&sdlCreateGPUDevice, lib, "SDL_CreateGPUDevice")
@@ -1357,19 +1357,19 @@ func init() {
 	purego.RegisterLibFunc(&sdlCreateGPUShader, lib, "SDL_CreateGPUShader")
 	purego.RegisterLibFunc(&sdlCreateGPUTexture, lib, "SDL_CreateGPUTexture")
 	purego.RegisterLibFunc(&sdlCreateGPUTransferBuffer, lib, "SDL_CreateGPUTransferBuffer")
-	// purego.RegisterLibFunc(&sdlCreateHapticEffect, lib, "SDL_CreateHapticEffect")
+	purego.RegisterLibFunc(&sdlCreateHapticEffect, lib, "SDL_CreateHapticEffect")
 	// purego.RegisterLibFunc(&sdlCreateMutex, lib, "SDL_CreateMutex")
 	purego.RegisterLibFunc(&sdlCreatePalette, lib, "SDL_CreatePalette")
 	// purego.RegisterLibFunc(&sdlCreatePopupWindow, lib, "SDL_CreatePopupWindow")
-	// purego.RegisterLibFunc(&sdlCreateProcess, lib, "SDL_CreateProcess")
-	// purego.RegisterLibFunc(&sdlCreateProcessWithProperties, lib, "SDL_CreateProcessWithProperties")
+	purego.RegisterLibFunc(&sdlCreateProcess, lib, "SDL_CreateProcess")
+	purego.RegisterLibFunc(&sdlCreateProcessWithProperties, lib, "SDL_CreateProcessWithProperties")
 	purego.RegisterLibFunc(&sdlCreateProperties, lib, "SDL_CreateProperties")
 	purego.RegisterLibFunc(&sdlCreateRenderer, lib, "SDL_CreateRenderer")
 	purego.RegisterLibFunc(&sdlCreateRendererWithProperties, lib, "SDL_CreateRendererWithProperties")
 	// purego.RegisterLibFunc(&sdlCreateRWLock, lib, "SDL_CreateRWLock")
 	// purego.RegisterLibFunc(&sdlCreateSemaphore, lib, "SDL_CreateSemaphore")
 	purego.RegisterLibFunc(&sdlCreateSoftwareRenderer, lib, "SDL_CreateSoftwareRenderer")
-	// purego.RegisterLibFunc(&sdlCreateStorageDirectory, lib, "SDL_CreateStorageDirectory")
+	purego.RegisterLibFunc(&sdlCreateStorageDirectory, lib, "SDL_CreateStorageDirectory")
 	purego.RegisterLibFunc(&sdlCreateSurface, lib, "SDL_CreateSurface")
 	purego.RegisterLibFunc(&sdlCreateSurfaceFrom, lib, "SDL_CreateSurfaceFrom")
 	purego.RegisterLibFunc(&sdlCreateSurfacePalette, lib, "SDL_CreateSurfacePalette")
@@ -1387,19 +1387,19 @@ func init() {
 	// purego.RegisterLibFunc(&sdlCreateWindowWithProperties, lib, "SDL_CreateWindowWithProperties")
 	purego.RegisterLibFunc(&sdlCursorVisible, lib, "SDL_CursorVisible")
 	// purego.RegisterLibFunc(&sdlDateTimeToTime, lib, "SDL_DateTimeToTime")
-	// purego.RegisterLibFunc(&sdlDelay, lib, "SDL_Delay")
+	purego.RegisterLibFunc(&sdlDelay, lib, "SDL_Delay")
 	purego.RegisterLibFunc(&sdlDelayNS, lib, "SDL_DelayNS")
 	// purego.RegisterLibFunc(&sdlDelayPrecise, lib, "SDL_DelayPrecise")
-	// purego.RegisterLibFunc(&sdlDestroyAsyncIOQueue, lib, "SDL_DestroyAsyncIOQueue")
+	purego.RegisterLibFunc(&sdlDestroyAsyncIOQueue, lib, "SDL_DestroyAsyncIOQueue")
 	purego.RegisterLibFunc(&sdlDestroyAudioStream, lib, "SDL_DestroyAudioStream")
 	// purego.RegisterLibFunc(&sdlDestroyCondition, lib, "SDL_DestroyCondition")
 	purego.RegisterLibFunc(&sdlDestroyCursor, lib, "SDL_DestroyCursor")
-	// purego.RegisterLibFunc(&sdlDestroyEnvironment, lib, "SDL_DestroyEnvironment")
+	purego.RegisterLibFunc(&sdlDestroyEnvironment, lib, "SDL_DestroyEnvironment")
 	purego.RegisterLibFunc(&sdlDestroyGPUDevice, lib, "SDL_DestroyGPUDevice")
-	// purego.RegisterLibFunc(&sdlDestroyHapticEffect, lib, "SDL_DestroyHapticEffect")
+	purego.RegisterLibFunc(&sdlDestroyHapticEffect, lib, "SDL_DestroyHapticEffect")
 	// purego.RegisterLibFunc(&sdlDestroyMutex, lib, "SDL_DestroyMutex")
 	purego.RegisterLibFunc(&sdlDestroyPalette, lib, "SDL_DestroyPalette")
-	// purego.RegisterLibFunc(&sdlDestroyProcess, lib, "SDL_DestroyProcess")
+	purego.RegisterLibFunc(&sdlDestroyProcess, lib, "SDL_DestroyProcess")
 	purego.RegisterLibFunc(&sdlDestroyProperties, lib, "SDL_DestroyProperties")
 	purego.RegisterLibFunc(&sdlDestroyRenderer, lib, "SDL_DestroyRenderer")
 	// purego.RegisterLibFunc(&sdlDestroyRWLock, lib, "SDL_DestroyRWLock")
@@ -1410,7 +1410,7 @@ func init() {
 	purego.RegisterLibFunc(&sdlDestroyWindow, lib, "SDL_DestroyWindow")
 	// purego.RegisterLibFunc(&sdlDestroyWindowSurface, lib, "SDL_DestroyWindowSurface")
 	// purego.RegisterLibFunc(&sdlDetachThread, lib, "SDL_DetachThread")
-	// purego.RegisterLibFunc(&sdlDetachVirtualJoystick, lib, "SDL_DetachVirtualJoystick")
+	purego.RegisterLibFunc(&sdlDetachVirtualJoystick, lib, "SDL_DetachVirtualJoystick")
 	// purego.RegisterLibFunc(&sdlDisableScreenSaver, lib, "SDL_DisableScreenSaver")
 	// purego.RegisterLibFunc(&sdlDispatchGPUCompute, lib, "SDL_DispatchGPUCompute")
 	// purego.RegisterLibFunc(&sdlDispatchGPUComputeIndirect, lib, "SDL_DispatchGPUComputeIndirect")
@@ -1431,9 +1431,9 @@ func init() {
 	purego.RegisterLibFunc(&sdlEndGPUCopyPass, lib, "SDL_EndGPUCopyPass")
 	purego.RegisterLibFunc(&sdlEndGPURenderPass, lib, "SDL_EndGPURenderPass")
 	// purego.RegisterLibFunc(&sdlEnterAppMainCallbacks, lib, "SDL_EnterAppMainCallbacks")
-	// purego.RegisterLibFunc(&sdlEnumerateDirectory, lib, "SDL_EnumerateDirectory")
+	purego.RegisterLibFunc(&sdlEnumerateDirectory, lib, "SDL_EnumerateDirectory")
 	purego.RegisterLibFunc(&sdlEnumerateProperties, lib, "SDL_EnumerateProperties")
-	// purego.RegisterLibFunc(&sdlEnumerateStorageDirectory, lib, "SDL_EnumerateStorageDirectory")
+	purego.RegisterLibFunc(&sdlEnumerateStorageDirectory, lib, "SDL_EnumerateStorageDirectory")
 	purego.RegisterLibFunc(&sdlEventEnabled, lib, "SDL_EventEnabled")
 	// purego.RegisterLibFunc(&sdlexp, lib, "SDL_exp")
 	// purego.RegisterLibFunc(&sdlexpf, lib, "SDL_expf")
@@ -1449,24 +1449,24 @@ func init() {
 	sdlFlushAudioStream = shared.Get(lib, "SDL_FlushAudioStream")
 	purego.RegisterLibFunc(&sdlFlushEvent, lib, "SDL_FlushEvent")
 	purego.RegisterLibFunc(&sdlFlushEvents, lib, "SDL_FlushEvents")
-	// purego.RegisterLibFunc(&sdlFlushIO, lib, "SDL_FlushIO")
+	purego.RegisterLibFunc(&sdlFlushIO, lib, "SDL_FlushIO")
 	sdlFlushRenderer = shared.Get(lib, "SDL_FlushRenderer")
 	// purego.RegisterLibFunc(&sdlfmod, lib, "SDL_fmod")
 	// purego.RegisterLibFunc(&sdlfmodf, lib, "SDL_fmodf")
 	sdlfree = shared.Get(lib, "SDL_free")
-	// purego.RegisterLibFunc(&sdlGamepadConnected, lib, "SDL_GamepadConnected")
-	// purego.RegisterLibFunc(&sdlGamepadEventsEnabled, lib, "SDL_GamepadEventsEnabled")
-	// purego.RegisterLibFunc(&sdlGamepadHasAxis, lib, "SDL_GamepadHasAxis")
-	// purego.RegisterLibFunc(&sdlGamepadHasButton, lib, "SDL_GamepadHasButton")
-	// purego.RegisterLibFunc(&sdlGamepadHasSensor, lib, "SDL_GamepadHasSensor")
-	// purego.RegisterLibFunc(&sdlGamepadSensorEnabled, lib, "SDL_GamepadSensorEnabled")
+	purego.RegisterLibFunc(&sdlGamepadConnected, lib, "SDL_GamepadConnected")
+	purego.RegisterLibFunc(&sdlGamepadEventsEnabled, lib, "SDL_GamepadEventsEnabled")
+	purego.RegisterLibFunc(&sdlGamepadHasAxis, lib, "SDL_GamepadHasAxis")
+	purego.RegisterLibFunc(&sdlGamepadHasButton, lib, "SDL_GamepadHasButton")
+	purego.RegisterLibFunc(&sdlGamepadHasSensor, lib, "SDL_GamepadHasSensor")
+	purego.RegisterLibFunc(&sdlGamepadSensorEnabled, lib, "SDL_GamepadSensorEnabled")
 	// purego.RegisterLibFunc(&sdlGDKSuspendComplete, lib, "SDL_GDKSuspendComplete")
 	// purego.RegisterLibFunc(&sdlGenerateMipmapsForGPUTexture, lib, "SDL_GenerateMipmapsForGPUTexture")
 	purego.RegisterLibFunc(&sdlGetAppMetadataProperty, lib, "SDL_GetAppMetadataProperty")
 	// purego.RegisterLibFunc(&sdlGetAssertionHandler, lib, "SDL_GetAssertionHandler")
 	// purego.RegisterLibFunc(&sdlGetAssertionReport, lib, "SDL_GetAssertionReport")
-	// purego.RegisterLibFunc(&sdlGetAsyncIOResult, lib, "SDL_GetAsyncIOResult")
-	// purego.RegisterLibFunc(&sdlGetAsyncIOSize, lib, "SDL_GetAsyncIOSize")
+	purego.RegisterLibFunc(&sdlGetAsyncIOResult, lib, "SDL_GetAsyncIOResult")
+	purego.RegisterLibFunc(&sdlGetAsyncIOSize, lib, "SDL_GetAsyncIOSize")
 	// purego.RegisterLibFunc(&sdlGetAtomicInt, lib, "SDL_GetAtomicInt")
 	// purego.RegisterLibFunc(&sdlGetAtomicPointer, lib, "SDL_GetAtomicPointer")
 	// purego.RegisterLibFunc(&sdlGetAtomicU32, lib, "SDL_GetAtomicU32")
@@ -1533,59 +1533,59 @@ func init() {
 	// purego.RegisterLibFunc(&sdlGetDisplayUsableBounds, lib, "SDL_GetDisplayUsableBounds")
 	// purego.RegisterLibFunc(&sdlgetenv, lib, "SDL_getenv")
 	// purego.RegisterLibFunc(&sdlgetenv_unsafe, lib, "SDL_getenv_unsafe")
-	// purego.RegisterLibFunc(&sdlGetEnvironment, lib, "SDL_GetEnvironment")
-	// purego.RegisterLibFunc(&sdlGetEnvironmentVariable, lib, "SDL_GetEnvironmentVariable")
-	// purego.RegisterLibFunc(&sdlGetEnvironmentVariables, lib, "SDL_GetEnvironmentVariables")
+	purego.RegisterLibFunc(&sdlGetEnvironment, lib, "SDL_GetEnvironment")
+	purego.RegisterLibFunc(&sdlGetEnvironmentVariable, lib, "SDL_GetEnvironmentVariable")
+	purego.RegisterLibFunc(&sdlGetEnvironmentVariables, lib, "SDL_GetEnvironmentVariables")
 	purego.RegisterLibFunc(&sdlGetError, lib, "SDL_GetError")
 	purego.RegisterLibFunc(&sdlGetEventFilter, lib, "SDL_GetEventFilter")
 	purego.RegisterLibFunc(&sdlGetFloatProperty, lib, "SDL_GetFloatProperty")
 	purego.RegisterLibFunc(&sdlGetFullscreenDisplayModes, lib, "SDL_GetFullscreenDisplayModes")
-	// purego.RegisterLibFunc(&sdlGetGamepadAppleSFSymbolsNameForAxis, lib, "SDL_GetGamepadAppleSFSymbolsNameForAxis")
-	// purego.RegisterLibFunc(&sdlGetGamepadAppleSFSymbolsNameForButton, lib, "SDL_GetGamepadAppleSFSymbolsNameForButton")
-	// purego.RegisterLibFunc(&sdlGetGamepadAxis, lib, "SDL_GetGamepadAxis")
-	// purego.RegisterLibFunc(&sdlGetGamepadAxisFromString, lib, "SDL_GetGamepadAxisFromString")
+	purego.RegisterLibFunc(&sdlGetGamepadAppleSFSymbolsNameForAxis, lib, "SDL_GetGamepadAppleSFSymbolsNameForAxis")
+	purego.RegisterLibFunc(&sdlGetGamepadAppleSFSymbolsNameForButton, lib, "SDL_GetGamepadAppleSFSymbolsNameForButton")
+	purego.RegisterLibFunc(&sdlGetGamepadAxis, lib, "SDL_GetGamepadAxis")
+	purego.RegisterLibFunc(&sdlGetGamepadAxisFromString, lib, "SDL_GetGamepadAxisFromString")
 	purego.RegisterLibFunc(&sdlGetGamepadBindings, lib, "SDL_GetGamepadBindings")
-	// purego.RegisterLibFunc(&sdlGetGamepadButton, lib, "SDL_GetGamepadButton")
-	// purego.RegisterLibFunc(&sdlGetGamepadButtonFromString, lib, "SDL_GetGamepadButtonFromString")
-	// purego.RegisterLibFunc(&sdlGetGamepadButtonLabel, lib, "SDL_GetGamepadButtonLabel")
-	// purego.RegisterLibFunc(&sdlGetGamepadButtonLabelForType, lib, "SDL_GetGamepadButtonLabelForType")
-	// purego.RegisterLibFunc(&sdlGetGamepadConnectionState, lib, "SDL_GetGamepadConnectionState")
-	// purego.RegisterLibFunc(&sdlGetGamepadFirmwareVersion, lib, "SDL_GetGamepadFirmwareVersion")
+	purego.RegisterLibFunc(&sdlGetGamepadButton, lib, "SDL_GetGamepadButton")
+	purego.RegisterLibFunc(&sdlGetGamepadButtonFromString, lib, "SDL_GetGamepadButtonFromString")
+	purego.RegisterLibFunc(&sdlGetGamepadButtonLabel, lib, "SDL_GetGamepadButtonLabel")
+	purego.RegisterLibFunc(&sdlGetGamepadButtonLabelForType, lib, "SDL_GetGamepadButtonLabelForType")
+	purego.RegisterLibFunc(&sdlGetGamepadConnectionState, lib, "SDL_GetGamepadConnectionState")
+	purego.RegisterLibFunc(&sdlGetGamepadFirmwareVersion, lib, "SDL_GetGamepadFirmwareVersion")
 	purego.RegisterLibFunc(&sdlGetGamepadFromID, lib, "SDL_GetGamepadFromID")
-	// purego.RegisterLibFunc(&sdlGetGamepadFromPlayerIndex, lib, "SDL_GetGamepadFromPlayerIndex")
-	// purego.RegisterLibFunc(&sdlGetGamepadGUIDForID, lib, "SDL_GetGamepadGUIDForID")
-	// purego.RegisterLibFunc(&sdlGetGamepadID, lib, "SDL_GetGamepadID")
-	// purego.RegisterLibFunc(&sdlGetGamepadJoystick, lib, "SDL_GetGamepadJoystick")
-	// purego.RegisterLibFunc(&sdlGetGamepadMapping, lib, "SDL_GetGamepadMapping")
-	// purego.RegisterLibFunc(&sdlGetGamepadMappingForGUID, lib, "SDL_GetGamepadMappingForGUID")
-	// purego.RegisterLibFunc(&sdlGetGamepadMappingForID, lib, "SDL_GetGamepadMappingForID")
-	// purego.RegisterLibFunc(&sdlGetGamepadMappings, lib, "SDL_GetGamepadMappings")
+	purego.RegisterLibFunc(&sdlGetGamepadFromPlayerIndex, lib, "SDL_GetGamepadFromPlayerIndex")
+	sdlGetGamepadGUIDForID = shared.Get(lib, "SDL_GetGamepadGUIDForID")
+	purego.RegisterLibFunc(&sdlGetGamepadID, lib, "SDL_GetGamepadID")
+	purego.RegisterLibFunc(&sdlGetGamepadJoystick, lib, "SDL_GetGamepadJoystick")
+	purego.RegisterLibFunc(&sdlGetGamepadMapping, lib, "SDL_GetGamepadMapping")
+	sdlGetGamepadMappingForGUID = shared.Get(lib, "SDL_GetGamepadMappingForGUID")
+	purego.RegisterLibFunc(&sdlGetGamepadMappingForID, lib, "SDL_GetGamepadMappingForID")
+	purego.RegisterLibFunc(&sdlGetGamepadMappings, lib, "SDL_GetGamepadMappings")
 	purego.RegisterLibFunc(&sdlGetGamepadName, lib, "SDL_GetGamepadName")
 	purego.RegisterLibFunc(&sdlGetGamepadNameForID, lib, "SDL_GetGamepadNameForID")
-	// purego.RegisterLibFunc(&sdlGetGamepadPath, lib, "SDL_GetGamepadPath")
-	// purego.RegisterLibFunc(&sdlGetGamepadPathForID, lib, "SDL_GetGamepadPathForID")
-	// purego.RegisterLibFunc(&sdlGetGamepadPlayerIndex, lib, "SDL_GetGamepadPlayerIndex")
-	// purego.RegisterLibFunc(&sdlGetGamepadPlayerIndexForID, lib, "SDL_GetGamepadPlayerIndexForID")
-	// purego.RegisterLibFunc(&sdlGetGamepadPowerInfo, lib, "SDL_GetGamepadPowerInfo")
-	// purego.RegisterLibFunc(&sdlGetGamepadProduct, lib, "SDL_GetGamepadProduct")
-	// purego.RegisterLibFunc(&sdlGetGamepadProductForID, lib, "SDL_GetGamepadProductForID")
-	// purego.RegisterLibFunc(&sdlGetGamepadProductVersion, lib, "SDL_GetGamepadProductVersion")
-	// purego.RegisterLibFunc(&sdlGetGamepadProductVersionForID, lib, "SDL_GetGamepadProductVersionForID")
-	// purego.RegisterLibFunc(&sdlGetGamepadProperties, lib, "SDL_GetGamepadProperties")
+	purego.RegisterLibFunc(&sdlGetGamepadPath, lib, "SDL_GetGamepadPath")
+	purego.RegisterLibFunc(&sdlGetGamepadPathForID, lib, "SDL_GetGamepadPathForID")
+	purego.RegisterLibFunc(&sdlGetGamepadPlayerIndex, lib, "SDL_GetGamepadPlayerIndex")
+	purego.RegisterLibFunc(&sdlGetGamepadPlayerIndexForID, lib, "SDL_GetGamepadPlayerIndexForID")
+	purego.RegisterLibFunc(&sdlGetGamepadPowerInfo, lib, "SDL_GetGamepadPowerInfo")
+	purego.RegisterLibFunc(&sdlGetGamepadProduct, lib, "SDL_GetGamepadProduct")
+	purego.RegisterLibFunc(&sdlGetGamepadProductForID, lib, "SDL_GetGamepadProductForID")
+	purego.RegisterLibFunc(&sdlGetGamepadProductVersion, lib, "SDL_GetGamepadProductVersion")
+	purego.RegisterLibFunc(&sdlGetGamepadProductVersionForID, lib, "SDL_GetGamepadProductVersionForID")
+	purego.RegisterLibFunc(&sdlGetGamepadProperties, lib, "SDL_GetGamepadProperties")
 	purego.RegisterLibFunc(&sdlGetGamepads, lib, "SDL_GetGamepads")
-	// purego.RegisterLibFunc(&sdlGetGamepadSensorData, lib, "SDL_GetGamepadSensorData")
-	// purego.RegisterLibFunc(&sdlGetGamepadSensorDataRate, lib, "SDL_GetGamepadSensorDataRate")
+	purego.RegisterLibFunc(&sdlGetGamepadSensorData, lib, "SDL_GetGamepadSensorData")
+	purego.RegisterLibFunc(&sdlGetGamepadSensorDataRate, lib, "SDL_GetGamepadSensorDataRate")
 	purego.RegisterLibFunc(&sdlGetGamepadSerial, lib, "SDL_GetGamepadSerial")
-	// purego.RegisterLibFunc(&sdlGetGamepadSteamHandle, lib, "SDL_GetGamepadSteamHandle")
-	// purego.RegisterLibFunc(&sdlGetGamepadStringForAxis, lib, "SDL_GetGamepadStringForAxis")
+	purego.RegisterLibFunc(&sdlGetGamepadSteamHandle, lib, "SDL_GetGamepadSteamHandle")
+	purego.RegisterLibFunc(&sdlGetGamepadStringForAxis, lib, "SDL_GetGamepadStringForAxis")
 	purego.RegisterLibFunc(&sdlGetGamepadStringForButton, lib, "SDL_GetGamepadStringForButton")
 	purego.RegisterLibFunc(&sdlGetGamepadStringForType, lib, "SDL_GetGamepadStringForType")
-	// purego.RegisterLibFunc(&sdlGetGamepadTouchpadFinger, lib, "SDL_GetGamepadTouchpadFinger")
+	purego.RegisterLibFunc(&sdlGetGamepadTouchpadFinger, lib, "SDL_GetGamepadTouchpadFinger")
 	purego.RegisterLibFunc(&sdlGetGamepadType, lib, "SDL_GetGamepadType")
-	// purego.RegisterLibFunc(&sdlGetGamepadTypeForID, lib, "SDL_GetGamepadTypeForID")
-	// purego.RegisterLibFunc(&sdlGetGamepadTypeFromString, lib, "SDL_GetGamepadTypeFromString")
-	// purego.RegisterLibFunc(&sdlGetGamepadVendor, lib, "SDL_GetGamepadVendor")
-	// purego.RegisterLibFunc(&sdlGetGamepadVendorForID, lib, "SDL_GetGamepadVendorForID")
+	purego.RegisterLibFunc(&sdlGetGamepadTypeForID, lib, "SDL_GetGamepadTypeForID")
+	purego.RegisterLibFunc(&sdlGetGamepadTypeFromString, lib, "SDL_GetGamepadTypeFromString")
+	purego.RegisterLibFunc(&sdlGetGamepadVendor, lib, "SDL_GetGamepadVendor")
+	purego.RegisterLibFunc(&sdlGetGamepadVendorForID, lib, "SDL_GetGamepadVendorForID")
 	purego.RegisterLibFunc(&sdlGetGlobalMouseState, lib, "SDL_GetGlobalMouseState")
 	purego.RegisterLibFunc(&sdlGetGlobalProperties, lib, "SDL_GetGlobalProperties")
 	purego.RegisterLibFunc(&sdlGetGPUDeviceDriver, lib, "SDL_GetGPUDeviceDriver")
@@ -1594,18 +1594,18 @@ func init() {
 	purego.RegisterLibFunc(&sdlGetGPUShaderFormats, lib, "SDL_GetGPUShaderFormats")
 	purego.RegisterLibFunc(&sdlGetGPUSwapchainTextureFormat, lib, "SDL_GetGPUSwapchainTextureFormat")
 	// purego.RegisterLibFunc(&sdlGetGrabbedWindow, lib, "SDL_GetGrabbedWindow")
-	// purego.RegisterLibFunc(&sdlGetHapticEffectStatus, lib, "SDL_GetHapticEffectStatus")
-	// purego.RegisterLibFunc(&sdlGetHapticFeatures, lib, "SDL_GetHapticFeatures")
-	// purego.RegisterLibFunc(&sdlGetHapticFromID, lib, "SDL_GetHapticFromID")
-	// purego.RegisterLibFunc(&sdlGetHapticID, lib, "SDL_GetHapticID")
-	// purego.RegisterLibFunc(&sdlGetHapticName, lib, "SDL_GetHapticName")
-	// purego.RegisterLibFunc(&sdlGetHapticNameForID, lib, "SDL_GetHapticNameForID")
-	// purego.RegisterLibFunc(&sdlGetHaptics, lib, "SDL_GetHaptics")
+	purego.RegisterLibFunc(&sdlGetHapticEffectStatus, lib, "SDL_GetHapticEffectStatus")
+	purego.RegisterLibFunc(&sdlGetHapticFeatures, lib, "SDL_GetHapticFeatures")
+	purego.RegisterLibFunc(&sdlGetHapticFromID, lib, "SDL_GetHapticFromID")
+	purego.RegisterLibFunc(&sdlGetHapticID, lib, "SDL_GetHapticID")
+	purego.RegisterLibFunc(&sdlGetHapticName, lib, "SDL_GetHapticName")
+	purego.RegisterLibFunc(&sdlGetHapticNameForID, lib, "SDL_GetHapticNameForID")
+	purego.RegisterLibFunc(&sdlGetHaptics, lib, "SDL_GetHaptics")
 	purego.RegisterLibFunc(&sdlGetHint, lib, "SDL_GetHint")
 	purego.RegisterLibFunc(&sdlGetHintBoolean, lib, "SDL_GetHintBoolean")
-	// purego.RegisterLibFunc(&sdlGetIOProperties, lib, "SDL_GetIOProperties")
-	// purego.RegisterLibFunc(&sdlGetIOSize, lib, "SDL_GetIOSize")
-	// purego.RegisterLibFunc(&sdlGetIOStatus, lib, "SDL_GetIOStatus")
+	purego.RegisterLibFunc(&sdlGetIOProperties, lib, "SDL_GetIOProperties")
+	purego.RegisterLibFunc(&sdlGetIOSize, lib, "SDL_GetIOSize")
+	purego.RegisterLibFunc(&sdlGetIOStatus, lib, "SDL_GetIOStatus")
 	purego.RegisterLibFunc(&sdlGetJoystickAxis, lib, "SDL_GetJoystickAxis")
 	purego.RegisterLibFunc(&sdlGetJoystickAxisInitialState, lib, "SDL_GetJoystickAxisInitialState")
 	purego.RegisterLibFunc(&sdlGetJoystickBall, lib, "SDL_GetJoystickBall")
@@ -1614,9 +1614,9 @@ func init() {
 	purego.RegisterLibFunc(&sdlGetJoystickFirmwareVersion, lib, "SDL_GetJoystickFirmwareVersion")
 	purego.RegisterLibFunc(&sdlGetJoystickFromID, lib, "SDL_GetJoystickFromID")
 	purego.RegisterLibFunc(&sdlGetJoystickFromPlayerIndex, lib, "SDL_GetJoystickFromPlayerIndex")
-	// purego.RegisterLibFunc(&sdlGetJoystickGUID, lib, "SDL_GetJoystickGUID")
-	// purego.RegisterLibFunc(&sdlGetJoystickGUIDForID, lib, "SDL_GetJoystickGUIDForID")
-	// purego.RegisterLibFunc(&sdlGetJoystickGUIDInfo, lib, "SDL_GetJoystickGUIDInfo")
+	sdlGetJoystickGUID = shared.Get(lib, "SDL_GetJoystickGUID")
+	sdlGetJoystickGUIDForID = shared.Get(lib, "SDL_GetJoystickGUIDForID")
+	sdlGetJoystickGUIDInfo = shared.Get(lib, "SDL_GetJoystickGUIDInfo")
 	purego.RegisterLibFunc(&sdlGetJoystickHat, lib, "SDL_GetJoystickHat")
 	purego.RegisterLibFunc(&sdlGetJoystickID, lib, "SDL_GetJoystickID")
 	purego.RegisterLibFunc(&sdlGetJoystickName, lib, "SDL_GetJoystickName")
@@ -1647,8 +1647,8 @@ func init() {
 	// purego.RegisterLibFunc(&sdlGetLogOutputFunction, lib, "SDL_GetLogOutputFunction")
 	// purego.RegisterLibFunc(&sdlGetLogPriority, lib, "SDL_GetLogPriority")
 	// purego.RegisterLibFunc(&sdlGetMasksForPixelFormat, lib, "SDL_GetMasksForPixelFormat")
-	// purego.RegisterLibFunc(&sdlGetMaxHapticEffects, lib, "SDL_GetMaxHapticEffects")
-	// purego.RegisterLibFunc(&sdlGetMaxHapticEffectsPlaying, lib, "SDL_GetMaxHapticEffectsPlaying")
+	purego.RegisterLibFunc(&sdlGetMaxHapticEffects, lib, "SDL_GetMaxHapticEffects")
+	purego.RegisterLibFunc(&sdlGetMaxHapticEffectsPlaying, lib, "SDL_GetMaxHapticEffectsPlaying")
 	// purego.RegisterLibFunc(&sdlGetMemoryFunctions, lib, "SDL_GetMemoryFunctions")
 	purego.RegisterLibFunc(&sdlGetMice, lib, "SDL_GetMice")
 	purego.RegisterLibFunc(&sdlGetModState, lib, "SDL_GetModState")
@@ -1660,10 +1660,10 @@ func init() {
 	purego.RegisterLibFunc(&sdlGetNumAudioDrivers, lib, "SDL_GetNumAudioDrivers")
 	purego.RegisterLibFunc(&sdlGetNumberProperty, lib, "SDL_GetNumberProperty")
 	purego.RegisterLibFunc(&sdlGetNumCameraDrivers, lib, "SDL_GetNumCameraDrivers")
-	// purego.RegisterLibFunc(&sdlGetNumGamepadTouchpadFingers, lib, "SDL_GetNumGamepadTouchpadFingers")
-	// purego.RegisterLibFunc(&sdlGetNumGamepadTouchpads, lib, "SDL_GetNumGamepadTouchpads")
+	purego.RegisterLibFunc(&sdlGetNumGamepadTouchpadFingers, lib, "SDL_GetNumGamepadTouchpadFingers")
+	purego.RegisterLibFunc(&sdlGetNumGamepadTouchpads, lib, "SDL_GetNumGamepadTouchpads")
 	purego.RegisterLibFunc(&sdlGetNumGPUDrivers, lib, "SDL_GetNumGPUDrivers")
-	// purego.RegisterLibFunc(&sdlGetNumHapticAxes, lib, "SDL_GetNumHapticAxes")
+	purego.RegisterLibFunc(&sdlGetNumHapticAxes, lib, "SDL_GetNumHapticAxes")
 	purego.RegisterLibFunc(&sdlGetNumJoystickAxes, lib, "SDL_GetNumJoystickAxes")
 	purego.RegisterLibFunc(&sdlGetNumJoystickBalls, lib, "SDL_GetNumJoystickBalls")
 	purego.RegisterLibFunc(&sdlGetNumJoystickButtons, lib, "SDL_GetNumJoystickButtons")
@@ -1672,7 +1672,7 @@ func init() {
 	purego.RegisterLibFunc(&sdlGetNumRenderDrivers, lib, "SDL_GetNumRenderDrivers")
 	purego.RegisterLibFunc(&sdlGetNumVideoDrivers, lib, "SDL_GetNumVideoDrivers")
 	// purego.RegisterLibFunc(&sdlGetOriginalMemoryFunctions, lib, "SDL_GetOriginalMemoryFunctions")
-	// purego.RegisterLibFunc(&sdlGetPathInfo, lib, "SDL_GetPathInfo")
+	purego.RegisterLibFunc(&sdlGetPathInfo, lib, "SDL_GetPathInfo")
 	sdlGetPerformanceCounter = shared.Get(lib, "SDL_GetPerformanceCounter")
 	sdlGetPerformanceFrequency = shared.Get(lib, "SDL_GetPerformanceFrequency")
 	purego.RegisterLibFunc(&sdlGetPixelFormatDetails, lib, "SDL_GetPixelFormatDetails")
@@ -1685,12 +1685,12 @@ func init() {
 	purego.RegisterLibFunc(&sdlGetPrefPath, lib, "SDL_GetPrefPath")
 	purego.RegisterLibFunc(&sdlGetPrimaryDisplay, lib, "SDL_GetPrimaryDisplay")
 	// purego.RegisterLibFunc(&sdlGetPrimarySelectionText, lib, "SDL_GetPrimarySelectionText")
-	// purego.RegisterLibFunc(&sdlGetProcessInput, lib, "SDL_GetProcessInput")
-	// purego.RegisterLibFunc(&sdlGetProcessOutput, lib, "SDL_GetProcessOutput")
-	// purego.RegisterLibFunc(&sdlGetProcessProperties, lib, "SDL_GetProcessProperties")
+	purego.RegisterLibFunc(&sdlGetProcessInput, lib, "SDL_GetProcessInput")
+	purego.RegisterLibFunc(&sdlGetProcessOutput, lib, "SDL_GetProcessOutput")
+	purego.RegisterLibFunc(&sdlGetProcessProperties, lib, "SDL_GetProcessProperties")
 	purego.RegisterLibFunc(&sdlGetPropertyType, lib, "SDL_GetPropertyType")
-	// purego.RegisterLibFunc(&sdlGetRealGamepadType, lib, "SDL_GetRealGamepadType")
-	// purego.RegisterLibFunc(&sdlGetRealGamepadTypeForID, lib, "SDL_GetRealGamepadTypeForID")
+	purego.RegisterLibFunc(&sdlGetRealGamepadType, lib, "SDL_GetRealGamepadType")
+	purego.RegisterLibFunc(&sdlGetRealGamepadTypeForID, lib, "SDL_GetRealGamepadTypeForID")
 	purego.RegisterLibFunc(&sdlGetRectAndLineIntersection, lib, "SDL_GetRectAndLineIntersection")
 	purego.RegisterLibFunc(&sdlGetRectAndLineIntersectionFloat, lib, "SDL_GetRectAndLineIntersectionFloat")
 	purego.RegisterLibFunc(&sdlGetRectEnclosingPoints, lib, "SDL_GetRectEnclosingPoints")
@@ -1729,22 +1729,22 @@ func init() {
 	purego.RegisterLibFunc(&sdlGetScancodeFromName, lib, "SDL_GetScancodeFromName")
 	purego.RegisterLibFunc(&sdlGetScancodeName, lib, "SDL_GetScancodeName")
 	// purego.RegisterLibFunc(&sdlGetSemaphoreValue, lib, "SDL_GetSemaphoreValue")
-	// purego.RegisterLibFunc(&sdlGetSensorData, lib, "SDL_GetSensorData")
-	// purego.RegisterLibFunc(&sdlGetSensorFromID, lib, "SDL_GetSensorFromID")
-	// purego.RegisterLibFunc(&sdlGetSensorID, lib, "SDL_GetSensorID")
-	// purego.RegisterLibFunc(&sdlGetSensorName, lib, "SDL_GetSensorName")
-	// purego.RegisterLibFunc(&sdlGetSensorNameForID, lib, "SDL_GetSensorNameForID")
-	// purego.RegisterLibFunc(&sdlGetSensorNonPortableType, lib, "SDL_GetSensorNonPortableType")
-	// purego.RegisterLibFunc(&sdlGetSensorNonPortableTypeForID, lib, "SDL_GetSensorNonPortableTypeForID")
-	// purego.RegisterLibFunc(&sdlGetSensorProperties, lib, "SDL_GetSensorProperties")
-	// purego.RegisterLibFunc(&sdlGetSensors, lib, "SDL_GetSensors")
-	// purego.RegisterLibFunc(&sdlGetSensorType, lib, "SDL_GetSensorType")
-	// purego.RegisterLibFunc(&sdlGetSensorTypeForID, lib, "SDL_GetSensorTypeForID")
+	purego.RegisterLibFunc(&sdlGetSensorData, lib, "SDL_GetSensorData")
+	purego.RegisterLibFunc(&sdlGetSensorFromID, lib, "SDL_GetSensorFromID")
+	purego.RegisterLibFunc(&sdlGetSensorID, lib, "SDL_GetSensorID")
+	purego.RegisterLibFunc(&sdlGetSensorName, lib, "SDL_GetSensorName")
+	purego.RegisterLibFunc(&sdlGetSensorNameForID, lib, "SDL_GetSensorNameForID")
+	purego.RegisterLibFunc(&sdlGetSensorNonPortableType, lib, "SDL_GetSensorNonPortableType")
+	purego.RegisterLibFunc(&sdlGetSensorNonPortableTypeForID, lib, "SDL_GetSensorNonPortableTypeForID")
+	purego.RegisterLibFunc(&sdlGetSensorProperties, lib, "SDL_GetSensorProperties")
+	purego.RegisterLibFunc(&sdlGetSensors, lib, "SDL_GetSensors")
+	purego.RegisterLibFunc(&sdlGetSensorType, lib, "SDL_GetSensorType")
+	purego.RegisterLibFunc(&sdlGetSensorTypeForID, lib, "SDL_GetSensorTypeForID")
 	// purego.RegisterLibFunc(&sdlGetSilenceValueForFormat, lib, "SDL_GetSilenceValueForFormat")
 	// purego.RegisterLibFunc(&sdlGetSIMDAlignment, lib, "SDL_GetSIMDAlignment")
-	// purego.RegisterLibFunc(&sdlGetStorageFileSize, lib, "SDL_GetStorageFileSize")
-	// purego.RegisterLibFunc(&sdlGetStoragePathInfo, lib, "SDL_GetStoragePathInfo")
-	// purego.RegisterLibFunc(&sdlGetStorageSpaceRemaining, lib, "SDL_GetStorageSpaceRemaining")
+	purego.RegisterLibFunc(&sdlGetStorageFileSize, lib, "SDL_GetStorageFileSize")
+	purego.RegisterLibFunc(&sdlGetStoragePathInfo, lib, "SDL_GetStoragePathInfo")
+	purego.RegisterLibFunc(&sdlGetStorageSpaceRemaining, lib, "SDL_GetStorageSpaceRemaining")
 	purego.RegisterLibFunc(&sdlGetStringProperty, lib, "SDL_GetStringProperty")
 	purego.RegisterLibFunc(&sdlGetSurfaceAlphaMod, lib, "SDL_GetSurfaceAlphaMod")
 	purego.RegisterLibFunc(&sdlGetSurfaceBlendMode, lib, "SDL_GetSurfaceBlendMode")
@@ -1772,10 +1772,10 @@ func init() {
 	sdlGetTicks = shared.Get(lib, "SDL_GetTicks")
 	sdlGetTicksNS = shared.Get(lib, "SDL_GetTicksNS")
 	// purego.RegisterLibFunc(&sdlGetTLS, lib, "SDL_GetTLS")
-	// purego.RegisterLibFunc(&sdlGetTouchDeviceName, lib, "SDL_GetTouchDeviceName")
-	// purego.RegisterLibFunc(&sdlGetTouchDevices, lib, "SDL_GetTouchDevices")
-	// purego.RegisterLibFunc(&sdlGetTouchDeviceType, lib, "SDL_GetTouchDeviceType")
-	// purego.RegisterLibFunc(&sdlGetTouchFingers, lib, "SDL_GetTouchFingers")
+	purego.RegisterLibFunc(&sdlGetTouchDeviceName, lib, "SDL_GetTouchDeviceName")
+	purego.RegisterLibFunc(&sdlGetTouchDevices, lib, "SDL_GetTouchDevices")
+	purego.RegisterLibFunc(&sdlGetTouchDeviceType, lib, "SDL_GetTouchDeviceType")
+	purego.RegisterLibFunc(&sdlGetTouchFingers, lib, "SDL_GetTouchFingers")
 	// purego.RegisterLibFunc(&sdlGetTrayEntries, lib, "SDL_GetTrayEntries")
 	// purego.RegisterLibFunc(&sdlGetTrayEntryChecked, lib, "SDL_GetTrayEntryChecked")
 	// purego.RegisterLibFunc(&sdlGetTrayEntryEnabled, lib, "SDL_GetTrayEntryEnabled")
@@ -1785,7 +1785,7 @@ func init() {
 	// purego.RegisterLibFunc(&sdlGetTrayMenuParentEntry, lib, "SDL_GetTrayMenuParentEntry")
 	// purego.RegisterLibFunc(&sdlGetTrayMenuParentTray, lib, "SDL_GetTrayMenuParentTray")
 	// purego.RegisterLibFunc(&sdlGetTraySubmenu, lib, "SDL_GetTraySubmenu")
-	// purego.RegisterLibFunc(&sdlGetUserFolder, lib, "SDL_GetUserFolder")
+	purego.RegisterLibFunc(&sdlGetUserFolder, lib, "SDL_GetUserFolder")
 	purego.RegisterLibFunc(&sdlGetVersion, lib, "SDL_GetVersion")
 	purego.RegisterLibFunc(&sdlGetVideoDriver, lib, "SDL_GetVideoDriver")
 	// purego.RegisterLibFunc(&sdlGetWindowAspectRatio, lib, "SDL_GetWindowAspectRatio")
@@ -1831,16 +1831,16 @@ func init() {
 	sdlGLSetSwapInterval = shared.Get(lib, "SDL_GL_SetSwapInterval")
 	sdlGLSwapWindow = shared.Get(lib, "SDL_GL_SwapWindow")
 	// purego.RegisterLibFunc(&sdlGL_UnloadLibrary, lib, "SDL_GL_UnloadLibrary")
-	// purego.RegisterLibFunc(&sdlGlobDirectory, lib, "SDL_GlobDirectory")
-	// purego.RegisterLibFunc(&sdlGlobStorageDirectory, lib, "SDL_GlobStorageDirectory")
+	purego.RegisterLibFunc(&sdlGlobDirectory, lib, "SDL_GlobDirectory")
+	purego.RegisterLibFunc(&sdlGlobStorageDirectory, lib, "SDL_GlobStorageDirectory")
 	// purego.RegisterLibFunc(&sdlGPUSupportsProperties, lib, "SDL_GPUSupportsProperties")
 	// purego.RegisterLibFunc(&sdlGPUSupportsShaderFormats, lib, "SDL_GPUSupportsShaderFormats")
 	// purego.RegisterLibFunc(&sdlGPUTextureFormatTexelBlockSize, lib, "SDL_GPUTextureFormatTexelBlockSize")
 	// purego.RegisterLibFunc(&sdlGPUTextureSupportsFormat, lib, "SDL_GPUTextureSupportsFormat")
 	// purego.RegisterLibFunc(&sdlGPUTextureSupportsSampleCount, lib, "SDL_GPUTextureSupportsSampleCount")
 	// purego.RegisterLibFunc(&sdlGUIDToString, lib, "SDL_GUIDToString")
-	// purego.RegisterLibFunc(&sdlHapticEffectSupported, lib, "SDL_HapticEffectSupported")
-	// purego.RegisterLibFunc(&sdlHapticRumbleSupported, lib, "SDL_HapticRumbleSupported")
+	purego.RegisterLibFunc(&sdlHapticEffectSupported, lib, "SDL_HapticEffectSupported")
+	purego.RegisterLibFunc(&sdlHapticRumbleSupported, lib, "SDL_HapticRumbleSupported")
 	// purego.RegisterLibFunc(&sdlHasAltiVec, lib, "SDL_HasAltiVec")
 	// purego.RegisterLibFunc(&sdlHasARMSIMD, lib, "SDL_HasARMSIMD")
 	// purego.RegisterLibFunc(&sdlHasAVX, lib, "SDL_HasAVX")
@@ -1851,7 +1851,7 @@ func init() {
 	purego.RegisterLibFunc(&sdlHasEvent, lib, "SDL_HasEvent")
 	purego.RegisterLibFunc(&sdlHasEvents, lib, "SDL_HasEvents")
 	// purego.RegisterLibFunc(&sdlHasExactlyOneBitSet32, lib, "SDL_HasExactlyOneBitSet32")
-	// purego.RegisterLibFunc(&sdlHasGamepad, lib, "SDL_HasGamepad")
+	purego.RegisterLibFunc(&sdlHasGamepad, lib, "SDL_HasGamepad")
 	purego.RegisterLibFunc(&sdlHasJoystick, lib, "SDL_HasJoystick")
 	purego.RegisterLibFunc(&sdlHasKeyboard, lib, "SDL_HasKeyboard")
 	// purego.RegisterLibFunc(&sdlHasLASX, lib, "SDL_HasLASX")
@@ -1869,28 +1869,28 @@ func init() {
 	// purego.RegisterLibFunc(&sdlHasSSE3, lib, "SDL_HasSSE3")
 	// purego.RegisterLibFunc(&sdlHasSSE41, lib, "SDL_HasSSE41")
 	// purego.RegisterLibFunc(&sdlHasSSE42, lib, "SDL_HasSSE42")
-	// purego.RegisterLibFunc(&sdlhid_ble_scan, lib, "SDL_hid_ble_scan")
-	// purego.RegisterLibFunc(&sdlhid_close, lib, "SDL_hid_close")
-	// purego.RegisterLibFunc(&sdlhid_device_change_count, lib, "SDL_hid_device_change_count")
-	// purego.RegisterLibFunc(&sdlhid_enumerate, lib, "SDL_hid_enumerate")
-	// purego.RegisterLibFunc(&sdlhid_exit, lib, "SDL_hid_exit")
-	// purego.RegisterLibFunc(&sdlhid_free_enumeration, lib, "SDL_hid_free_enumeration")
-	// purego.RegisterLibFunc(&sdlhid_get_device_info, lib, "SDL_hid_get_device_info")
-	// purego.RegisterLibFunc(&sdlhid_get_feature_report, lib, "SDL_hid_get_feature_report")
-	// purego.RegisterLibFunc(&sdlhid_get_indexed_string, lib, "SDL_hid_get_indexed_string")
-	// purego.RegisterLibFunc(&sdlhid_get_input_report, lib, "SDL_hid_get_input_report")
-	// purego.RegisterLibFunc(&sdlhid_get_manufacturer_string, lib, "SDL_hid_get_manufacturer_string")
-	// purego.RegisterLibFunc(&sdlhid_get_product_string, lib, "SDL_hid_get_product_string")
-	// purego.RegisterLibFunc(&sdlhid_get_report_descriptor, lib, "SDL_hid_get_report_descriptor")
-	// purego.RegisterLibFunc(&sdlhid_get_serial_number_string, lib, "SDL_hid_get_serial_number_string")
-	// purego.RegisterLibFunc(&sdlhid_init, lib, "SDL_hid_init")
-	// purego.RegisterLibFunc(&sdlhid_open, lib, "SDL_hid_open")
-	// purego.RegisterLibFunc(&sdlhid_open_path, lib, "SDL_hid_open_path")
-	// purego.RegisterLibFunc(&sdlhid_read, lib, "SDL_hid_read")
-	// purego.RegisterLibFunc(&sdlhid_read_timeout, lib, "SDL_hid_read_timeout")
-	// purego.RegisterLibFunc(&sdlhid_send_feature_report, lib, "SDL_hid_send_feature_report")
-	// purego.RegisterLibFunc(&sdlhid_set_nonblocking, lib, "SDL_hid_set_nonblocking")
-	// purego.RegisterLibFunc(&sdlhid_write, lib, "SDL_hid_write")
+	purego.RegisterLibFunc(&sdlHidBleScan, lib, "SDL_hid_ble_scan")
+	purego.RegisterLibFunc(&sdlHidClose, lib, "SDL_hid_close")
+	purego.RegisterLibFunc(&sdlHidDeviceChangeCount, lib, "SDL_hid_device_change_count")
+	purego.RegisterLibFunc(&sdlHidEnumerate, lib, "SDL_hid_enumerate")
+	purego.RegisterLibFunc(&sdlHidExit, lib, "SDL_hid_exit")
+	purego.RegisterLibFunc(&sdlHidFreeEnumeration, lib, "SDL_hid_free_enumeration")
+	purego.RegisterLibFunc(&sdlHidGetDeviceInfo, lib, "SDL_hid_get_device_info")
+	purego.RegisterLibFunc(&sdlHidGetFeatureReport, lib, "SDL_hid_get_feature_report")
+	purego.RegisterLibFunc(&sdlHidGetIndexedString, lib, "SDL_hid_get_indexed_string")
+	purego.RegisterLibFunc(&sdlHidGetInputReport, lib, "SDL_hid_get_input_report")
+	purego.RegisterLibFunc(&sdlHidGetManufacturerString, lib, "SDL_hid_get_manufacturer_string")
+	purego.RegisterLibFunc(&sdlHidGetProductString, lib, "SDL_hid_get_product_string")
+	purego.RegisterLibFunc(&sdlHidGetReportDescriptor, lib, "SDL_hid_get_report_descriptor")
+	purego.RegisterLibFunc(&sdlHidGetSerialNumberString, lib, "SDL_hid_get_serial_number_string")
+	purego.RegisterLibFunc(&sdlHidInit, lib, "SDL_hid_init")
+	purego.RegisterLibFunc(&sdlHidOpen, lib, "SDL_hid_open")
+	purego.RegisterLibFunc(&sdlHidOpenPath, lib, "SDL_hid_open_path")
+	purego.RegisterLibFunc(&sdlHidRead, lib, "SDL_hid_read")
+	purego.RegisterLibFunc(&sdlHidReadTimeout, lib, "SDL_hid_read_timeout")
+	purego.RegisterLibFunc(&sdlHidSendFeatureReport, lib, "SDL_hid_send_feature_report")
+	purego.RegisterLibFunc(&sdlHidSetNonblocking, lib, "SDL_hid_set_nonblocking")
+	purego.RegisterLibFunc(&sdlHidWrite, lib, "SDL_hid_write")
 	purego.RegisterLibFunc(&sdlHideCursor, lib, "SDL_HideCursor")
 	purego.RegisterLibFunc(&sdlHideWindow, lib, "SDL_HideWindow")
 	// purego.RegisterLibFunc(&sdliconv, lib, "SDL_iconv")
@@ -1898,12 +1898,12 @@ func init() {
 	// purego.RegisterLibFunc(&sdliconv_open, lib, "SDL_iconv_open")
 	// purego.RegisterLibFunc(&sdliconv_string, lib, "SDL_iconv_string")
 	purego.RegisterLibFunc(&sdlInit, lib, "SDL_Init")
-	// purego.RegisterLibFunc(&sdlInitHapticRumble, lib, "SDL_InitHapticRumble")
+	purego.RegisterLibFunc(&sdlInitHapticRumble, lib, "SDL_InitHapticRumble")
 	// purego.RegisterLibFunc(&sdlInitSubSystem, lib, "SDL_InitSubSystem")
 	// purego.RegisterLibFunc(&sdlInsertGPUDebugLabel, lib, "SDL_InsertGPUDebugLabel")
 	// purego.RegisterLibFunc(&sdlInsertTrayEntryAt, lib, "SDL_InsertTrayEntryAt")
 	purego.RegisterLibFunc(&sdlIOFromConstMem, lib, "SDL_IOFromConstMem")
-	// purego.RegisterLibFunc(&sdlIOFromDynamicMem, lib, "SDL_IOFromDynamicMem")
+	purego.RegisterLibFunc(&sdlIOFromDynamicMem, lib, "SDL_IOFromDynamicMem")
 	purego.RegisterLibFunc(&sdlIOFromFile, lib, "SDL_IOFromFile")
 	purego.RegisterLibFunc(&sdlIOFromMem, lib, "SDL_IOFromMem")
 	// purego.RegisterLibFunc(&sdlIOprintf, lib, "SDL_IOprintf")
@@ -1915,15 +1915,15 @@ func init() {
 	// purego.RegisterLibFunc(&sdlisblank, lib, "SDL_isblank")
 	// purego.RegisterLibFunc(&sdliscntrl, lib, "SDL_iscntrl")
 	// purego.RegisterLibFunc(&sdlisdigit, lib, "SDL_isdigit")
-	// purego.RegisterLibFunc(&sdlIsGamepad, lib, "SDL_IsGamepad")
+	purego.RegisterLibFunc(&sdlIsGamepad, lib, "SDL_IsGamepad")
 	// purego.RegisterLibFunc(&sdlisgraph, lib, "SDL_isgraph")
 	// purego.RegisterLibFunc(&sdlisinf, lib, "SDL_isinf")
 	// purego.RegisterLibFunc(&sdlisinff, lib, "SDL_isinff")
-	// purego.RegisterLibFunc(&sdlIsJoystickHaptic, lib, "SDL_IsJoystickHaptic")
-	// purego.RegisterLibFunc(&sdlIsJoystickVirtual, lib, "SDL_IsJoystickVirtual")
+	purego.RegisterLibFunc(&sdlIsJoystickHaptic, lib, "SDL_IsJoystickHaptic")
+	purego.RegisterLibFunc(&sdlIsJoystickVirtual, lib, "SDL_IsJoystickVirtual")
 	// purego.RegisterLibFunc(&sdlislower, lib, "SDL_islower")
 	purego.RegisterLibFunc(&sdlIsMainThread, lib, "SDL_IsMainThread")
-	// purego.RegisterLibFunc(&sdlIsMouseHaptic, lib, "SDL_IsMouseHaptic")
+	purego.RegisterLibFunc(&sdlIsMouseHaptic, lib, "SDL_IsMouseHaptic")
 	// purego.RegisterLibFunc(&sdlisnan, lib, "SDL_isnan")
 	// purego.RegisterLibFunc(&sdlisnanf, lib, "SDL_isnanf")
 	// purego.RegisterLibFunc(&sdlisprint, lib, "SDL_isprint")
@@ -1936,13 +1936,13 @@ func init() {
 	// purego.RegisterLibFunc(&sdlitoa, lib, "SDL_itoa")
 	purego.RegisterLibFunc(&sdlJoystickConnected, lib, "SDL_JoystickConnected")
 	purego.RegisterLibFunc(&sdlJoystickEventsEnabled, lib, "SDL_JoystickEventsEnabled")
-	// purego.RegisterLibFunc(&sdlKillProcess, lib, "SDL_KillProcess")
+	purego.RegisterLibFunc(&sdlKillProcess, lib, "SDL_KillProcess")
 	// purego.RegisterLibFunc(&sdllltoa, lib, "SDL_lltoa")
 	purego.RegisterLibFunc(&sdlLoadBMP, lib, "SDL_LoadBMP")
 	purego.RegisterLibFunc(&sdlLoadBMPIO, lib, "SDL_LoadBMP_IO")
 	purego.RegisterLibFunc(&sdlLoadFile, lib, "SDL_LoadFile")
-	// purego.RegisterLibFunc(&sdlLoadFile_IO, lib, "SDL_LoadFile_IO")
-	// purego.RegisterLibFunc(&sdlLoadFileAsync, lib, "SDL_LoadFileAsync")
+	purego.RegisterLibFunc(&sdlLoadFile_IO, lib, "SDL_LoadFile_IO")
+	purego.RegisterLibFunc(&sdlLoadFileAsync, lib, "SDL_LoadFileAsync")
 	// purego.RegisterLibFunc(&sdlLoadFunction, lib, "SDL_LoadFunction")
 	// purego.RegisterLibFunc(&sdlLoadObject, lib, "SDL_LoadObject")
 	// purego.RegisterLibFunc(&sdlLoadWAV, lib, "SDL_LoadWAV")
@@ -1975,7 +1975,7 @@ func init() {
 	// purego.RegisterLibFunc(&sdllroundf, lib, "SDL_lroundf")
 	// purego.RegisterLibFunc(&sdlltoa, lib, "SDL_ltoa")
 	// purego.RegisterLibFunc(&sdlmain, lib, "SDL_main")
-	// purego.RegisterLibFunc(&sdlmalloc, lib, "SDL_malloc")
+	purego.RegisterLibFunc(&sdlmalloc, lib, "SDL_malloc")
 	purego.RegisterLibFunc(&sdlMapGPUTransferBuffer, lib, "SDL_MapGPUTransferBuffer")
 	purego.RegisterLibFunc(&sdlMapRGB, lib, "SDL_MapRGB")
 	// purego.RegisterLibFunc(&sdlMapRGBA, lib, "SDL_MapRGBA")
@@ -2007,24 +2007,24 @@ func init() {
 	// purego.RegisterLibFunc(&sdlOpenAudioDevice, lib, "SDL_OpenAudioDevice")
 	purego.RegisterLibFunc(&sdlOpenAudioDeviceStream, lib, "SDL_OpenAudioDeviceStream")
 	purego.RegisterLibFunc(&sdlOpenCamera, lib, "SDL_OpenCamera")
-	// purego.RegisterLibFunc(&sdlOpenFileStorage, lib, "SDL_OpenFileStorage")
+	purego.RegisterLibFunc(&sdlOpenFileStorage, lib, "SDL_OpenFileStorage")
 	purego.RegisterLibFunc(&sdlOpenGamepad, lib, "SDL_OpenGamepad")
-	// purego.RegisterLibFunc(&sdlOpenHaptic, lib, "SDL_OpenHaptic")
-	// purego.RegisterLibFunc(&sdlOpenHapticFromJoystick, lib, "SDL_OpenHapticFromJoystick")
-	// purego.RegisterLibFunc(&sdlOpenHapticFromMouse, lib, "SDL_OpenHapticFromMouse")
-	// purego.RegisterLibFunc(&sdlOpenIO, lib, "SDL_OpenIO")
+	purego.RegisterLibFunc(&sdlOpenHaptic, lib, "SDL_OpenHaptic")
+	purego.RegisterLibFunc(&sdlOpenHapticFromJoystick, lib, "SDL_OpenHapticFromJoystick")
+	purego.RegisterLibFunc(&sdlOpenHapticFromMouse, lib, "SDL_OpenHapticFromMouse")
+	purego.RegisterLibFunc(&sdlOpenIO, lib, "SDL_OpenIO")
 	purego.RegisterLibFunc(&sdlOpenJoystick, lib, "SDL_OpenJoystick")
-	// purego.RegisterLibFunc(&sdlOpenSensor, lib, "SDL_OpenSensor")
-	// purego.RegisterLibFunc(&sdlOpenStorage, lib, "SDL_OpenStorage")
-	// purego.RegisterLibFunc(&sdlOpenTitleStorage, lib, "SDL_OpenTitleStorage")
+	purego.RegisterLibFunc(&sdlOpenSensor, lib, "SDL_OpenSensor")
+	purego.RegisterLibFunc(&sdlOpenStorage, lib, "SDL_OpenStorage")
+	purego.RegisterLibFunc(&sdlOpenTitleStorage, lib, "SDL_OpenTitleStorage")
 	purego.RegisterLibFunc(&sdlOpenURL, lib, "SDL_OpenURL")
-	// purego.RegisterLibFunc(&sdlOpenUserStorage, lib, "SDL_OpenUserStorage")
+	purego.RegisterLibFunc(&sdlOpenUserStorage, lib, "SDL_OpenUserStorage")
 	// purego.RegisterLibFunc(&sdlOutOfMemory, lib, "SDL_OutOfMemory")
 	// purego.RegisterLibFunc(&sdlPauseAudioDevice, lib, "SDL_PauseAudioDevice")
 	sdlPauseAudioStreamDevice = shared.Get(lib, "SDL_PauseAudioStreamDevice")
-	// purego.RegisterLibFunc(&sdlPauseHaptic, lib, "SDL_PauseHaptic")
+	purego.RegisterLibFunc(&sdlPauseHaptic, lib, "SDL_PauseHaptic")
 	purego.RegisterLibFunc(&sdlPeepEvents, lib, "SDL_PeepEvents")
-	// purego.RegisterLibFunc(&sdlPlayHapticRumble, lib, "SDL_PlayHapticRumble")
+	purego.RegisterLibFunc(&sdlPlayHapticRumble, lib, "SDL_PlayHapticRumble")
 	sdlPollEvent = shared.Get(lib, "SDL_PollEvent")
 	// purego.RegisterLibFunc(&sdlPopGPUDebugGroup, lib, "SDL_PopGPUDebugGroup")
 	// purego.RegisterLibFunc(&sdlpow, lib, "SDL_pow")
@@ -2050,9 +2050,9 @@ func init() {
 	// purego.RegisterLibFunc(&sdlrand_r, lib, "SDL_rand_r")
 	// purego.RegisterLibFunc(&sdlrandf, lib, "SDL_randf")
 	// purego.RegisterLibFunc(&sdlrandf_r, lib, "SDL_randf_r")
-	// purego.RegisterLibFunc(&sdlReadAsyncIO, lib, "SDL_ReadAsyncIO")
-	// purego.RegisterLibFunc(&sdlReadIO, lib, "SDL_ReadIO")
-	// purego.RegisterLibFunc(&sdlReadProcess, lib, "SDL_ReadProcess")
+	purego.RegisterLibFunc(&sdlReadAsyncIO, lib, "SDL_ReadAsyncIO")
+	purego.RegisterLibFunc(&sdlReadIO, lib, "SDL_ReadIO")
+	purego.RegisterLibFunc(&sdlReadProcess, lib, "SDL_ReadProcess")
 	// purego.RegisterLibFunc(&sdlReadS16BE, lib, "SDL_ReadS16BE")
 	// purego.RegisterLibFunc(&sdlReadS16LE, lib, "SDL_ReadS16LE")
 	// purego.RegisterLibFunc(&sdlReadS32BE, lib, "SDL_ReadS32BE")
@@ -2060,7 +2060,7 @@ func init() {
 	// purego.RegisterLibFunc(&sdlReadS64BE, lib, "SDL_ReadS64BE")
 	// purego.RegisterLibFunc(&sdlReadS64LE, lib, "SDL_ReadS64LE")
 	// purego.RegisterLibFunc(&sdlReadS8, lib, "SDL_ReadS8")
-	// purego.RegisterLibFunc(&sdlReadStorageFile, lib, "SDL_ReadStorageFile")
+	purego.RegisterLibFunc(&sdlReadStorageFile, lib, "SDL_ReadStorageFile")
 	// purego.RegisterLibFunc(&sdlReadSurfacePixel, lib, "SDL_ReadSurfacePixel")
 	// purego.RegisterLibFunc(&sdlReadSurfacePixelFloat, lib, "SDL_ReadSurfacePixelFloat")
 	// purego.RegisterLibFunc(&sdlReadU16BE, lib, "SDL_ReadU16BE")
@@ -2082,16 +2082,16 @@ func init() {
 	purego.RegisterLibFunc(&sdlReleaseGPUTexture, lib, "SDL_ReleaseGPUTexture")
 	purego.RegisterLibFunc(&sdlReleaseGPUTransferBuffer, lib, "SDL_ReleaseGPUTransferBuffer")
 	purego.RegisterLibFunc(&sdlReleaseWindowFromGPUDevice, lib, "SDL_ReleaseWindowFromGPUDevice")
-	// purego.RegisterLibFunc(&sdlReloadGamepadMappings, lib, "SDL_ReloadGamepadMappings")
+	purego.RegisterLibFunc(&sdlReloadGamepadMappings, lib, "SDL_ReloadGamepadMappings")
 	purego.RegisterLibFunc(&sdlRemoveEventWatch, lib, "SDL_RemoveEventWatch")
 	purego.RegisterLibFunc(&sdlRemoveHintCallback, lib, "SDL_RemoveHintCallback")
-	// purego.RegisterLibFunc(&sdlRemovePath, lib, "SDL_RemovePath")
-	// purego.RegisterLibFunc(&sdlRemoveStoragePath, lib, "SDL_RemoveStoragePath")
+	purego.RegisterLibFunc(&sdlRemovePath, lib, "SDL_RemovePath")
+	purego.RegisterLibFunc(&sdlRemoveStoragePath, lib, "SDL_RemoveStoragePath")
 	purego.RegisterLibFunc(&sdlRemoveSurfaceAlternateImages, lib, "SDL_RemoveSurfaceAlternateImages")
 	// purego.RegisterLibFunc(&sdlRemoveTimer, lib, "SDL_RemoveTimer")
 	// purego.RegisterLibFunc(&sdlRemoveTrayEntry, lib, "SDL_RemoveTrayEntry")
-	// purego.RegisterLibFunc(&sdlRenamePath, lib, "SDL_RenamePath")
-	// purego.RegisterLibFunc(&sdlRenameStoragePath, lib, "SDL_RenameStoragePath")
+	purego.RegisterLibFunc(&sdlRenamePath, lib, "SDL_RenamePath")
+	purego.RegisterLibFunc(&sdlRenameStoragePath, lib, "SDL_RenameStoragePath")
 	sdlRenderClear = shared.Get(lib, "SDL_RenderClear")
 	purego.RegisterLibFunc(&sdlRenderClipEnabled, lib, "SDL_RenderClipEnabled")
 	purego.RegisterLibFunc(&sdlRenderCoordinatesFromWindow, lib, "SDL_RenderCoordinatesFromWindow")
@@ -2125,29 +2125,29 @@ func init() {
 	purego.RegisterLibFunc(&sdlRestoreWindow, lib, "SDL_RestoreWindow")
 	// purego.RegisterLibFunc(&sdlResumeAudioDevice, lib, "SDL_ResumeAudioDevice")
 	sdlResumeAudioStreamDevice = shared.Get(lib, "SDL_ResumeAudioStreamDevice")
-	// purego.RegisterLibFunc(&sdlResumeHaptic, lib, "SDL_ResumeHaptic")
+	purego.RegisterLibFunc(&sdlResumeHaptic, lib, "SDL_ResumeHaptic")
 	// purego.RegisterLibFunc(&sdlround, lib, "SDL_round")
 	// purego.RegisterLibFunc(&sdlroundf, lib, "SDL_roundf")
-	// purego.RegisterLibFunc(&sdlRumbleGamepad, lib, "SDL_RumbleGamepad")
-	// purego.RegisterLibFunc(&sdlRumbleGamepadTriggers, lib, "SDL_RumbleGamepadTriggers")
+	purego.RegisterLibFunc(&sdlRumbleGamepad, lib, "SDL_RumbleGamepad")
+	purego.RegisterLibFunc(&sdlRumbleGamepadTriggers, lib, "SDL_RumbleGamepadTriggers")
 	purego.RegisterLibFunc(&sdlRumbleJoystick, lib, "SDL_RumbleJoystick")
 	purego.RegisterLibFunc(&sdlRumbleJoystickTriggers, lib, "SDL_RumbleJoystickTriggers")
 	// purego.RegisterLibFunc(&sdlRunApp, lib, "SDL_RunApp")
-	// purego.RegisterLibFunc(&sdlRunHapticEffect, lib, "SDL_RunHapticEffect")
+	purego.RegisterLibFunc(&sdlRunHapticEffect, lib, "SDL_RunHapticEffect")
 	// purego.RegisterLibFunc(&sdlRunOnMainThread, lib, "SDL_RunOnMainThread")
 	purego.RegisterLibFunc(&sdlSaveBMP, lib, "SDL_SaveBMP")
 	purego.RegisterLibFunc(&sdlSaveBMPIO, lib, "SDL_SaveBMP_IO")
-	// purego.RegisterLibFunc(&sdlSaveFile, lib, "SDL_SaveFile")
-	// purego.RegisterLibFunc(&sdlSaveFile_IO, lib, "SDL_SaveFile_IO")
+	purego.RegisterLibFunc(&sdlSaveFile, lib, "SDL_SaveFile")
+	purego.RegisterLibFunc(&sdlSaveFile_IO, lib, "SDL_SaveFile_IO")
 	// purego.RegisterLibFunc(&sdlscalbn, lib, "SDL_scalbn")
 	// purego.RegisterLibFunc(&sdlscalbnf, lib, "SDL_scalbnf")
 	purego.RegisterLibFunc(&sdlScaleSurface, lib, "SDL_ScaleSurface")
 	purego.RegisterLibFunc(&sdlScreenKeyboardShown, lib, "SDL_ScreenKeyboardShown")
 	// purego.RegisterLibFunc(&sdlScreenSaverEnabled, lib, "SDL_ScreenSaverEnabled")
-	// purego.RegisterLibFunc(&sdlSeekIO, lib, "SDL_SeekIO")
-	// purego.RegisterLibFunc(&sdlSendGamepadEffect, lib, "SDL_SendGamepadEffect")
+	purego.RegisterLibFunc(&sdlSeekIO, lib, "SDL_SeekIO")
+	purego.RegisterLibFunc(&sdlSendGamepadEffect, lib, "SDL_SendGamepadEffect")
 	purego.RegisterLibFunc(&sdlSendJoystickEffect, lib, "SDL_SendJoystickEffect")
-	// purego.RegisterLibFunc(&sdlSendJoystickVirtualSensorData, lib, "SDL_SendJoystickVirtualSensorData")
+	purego.RegisterLibFunc(&sdlSendJoystickVirtualSensorData, lib, "SDL_SendJoystickVirtualSensorData")
 	// purego.RegisterLibFunc(&sdlSetAppMetadata, lib, "SDL_SetAppMetadata")
 	// purego.RegisterLibFunc(&sdlSetAppMetadataProperty, lib, "SDL_SetAppMetadataProperty")
 	// purego.RegisterLibFunc(&sdlSetAssertionHandler, lib, "SDL_SetAssertionHandler")
@@ -2169,17 +2169,17 @@ func init() {
 	// purego.RegisterLibFunc(&sdlSetCurrentThreadPriority, lib, "SDL_SetCurrentThreadPriority")
 	purego.RegisterLibFunc(&sdlSetCursor, lib, "SDL_SetCursor")
 	// purego.RegisterLibFunc(&sdlsetenv_unsafe, lib, "SDL_setenv_unsafe")
-	// purego.RegisterLibFunc(&sdlSetEnvironmentVariable, lib, "SDL_SetEnvironmentVariable")
+	purego.RegisterLibFunc(&sdlSetEnvironmentVariable, lib, "SDL_SetEnvironmentVariable")
 	purego.RegisterLibFunc(&sdlSetError, lib, "SDL_SetError")
 	// purego.RegisterLibFunc(&sdlSetErrorV, lib, "SDL_SetErrorV")
 	purego.RegisterLibFunc(&sdlSetEventEnabled, lib, "SDL_SetEventEnabled")
 	purego.RegisterLibFunc(&sdlSetEventFilter, lib, "SDL_SetEventFilter")
 	purego.RegisterLibFunc(&sdlSetFloatProperty, lib, "SDL_SetFloatProperty")
-	// purego.RegisterLibFunc(&sdlSetGamepadEventsEnabled, lib, "SDL_SetGamepadEventsEnabled")
-	// purego.RegisterLibFunc(&sdlSetGamepadLED, lib, "SDL_SetGamepadLED")
-	// purego.RegisterLibFunc(&sdlSetGamepadMapping, lib, "SDL_SetGamepadMapping")
-	// purego.RegisterLibFunc(&sdlSetGamepadPlayerIndex, lib, "SDL_SetGamepadPlayerIndex")
-	// purego.RegisterLibFunc(&sdlSetGamepadSensorEnabled, lib, "SDL_SetGamepadSensorEnabled")
+	purego.RegisterLibFunc(&sdlSetGamepadEventsEnabled, lib, "SDL_SetGamepadEventsEnabled")
+	purego.RegisterLibFunc(&sdlSetGamepadLED, lib, "SDL_SetGamepadLED")
+	purego.RegisterLibFunc(&sdlSetGamepadMapping, lib, "SDL_SetGamepadMapping")
+	purego.RegisterLibFunc(&sdlSetGamepadPlayerIndex, lib, "SDL_SetGamepadPlayerIndex")
+	purego.RegisterLibFunc(&sdlSetGamepadSensorEnabled, lib, "SDL_SetGamepadSensorEnabled")
 	// purego.RegisterLibFunc(&sdlSetGPUAllowedFramesInFlight, lib, "SDL_SetGPUAllowedFramesInFlight")
 	// purego.RegisterLibFunc(&sdlSetGPUBlendConstants, lib, "SDL_SetGPUBlendConstants")
 	purego.RegisterLibFunc(&sdlSetGPUBufferName, lib, "SDL_SetGPUBufferName")
@@ -2188,19 +2188,19 @@ func init() {
 	purego.RegisterLibFunc(&sdlSetGPUSwapchainParameters, lib, "SDL_SetGPUSwapchainParameters")
 	// purego.RegisterLibFunc(&sdlSetGPUTextureName, lib, "SDL_SetGPUTextureName")
 	purego.RegisterLibFunc(&sdlSetGPUViewport, lib, "SDL_SetGPUViewport")
-	// purego.RegisterLibFunc(&sdlSetHapticAutocenter, lib, "SDL_SetHapticAutocenter")
-	// purego.RegisterLibFunc(&sdlSetHapticGain, lib, "SDL_SetHapticGain")
+	purego.RegisterLibFunc(&sdlSetHapticAutocenter, lib, "SDL_SetHapticAutocenter")
+	purego.RegisterLibFunc(&sdlSetHapticGain, lib, "SDL_SetHapticGain")
 	purego.RegisterLibFunc(&sdlSetHint, lib, "SDL_SetHint")
 	purego.RegisterLibFunc(&sdlSetHintWithPriority, lib, "SDL_SetHintWithPriority")
 	// purego.RegisterLibFunc(&sdlSetInitialized, lib, "SDL_SetInitialized")
 	purego.RegisterLibFunc(&sdlSetJoystickEventsEnabled, lib, "SDL_SetJoystickEventsEnabled")
 	purego.RegisterLibFunc(&sdlSetJoystickLED, lib, "SDL_SetJoystickLED")
 	purego.RegisterLibFunc(&sdlSetJoystickPlayerIndex, lib, "SDL_SetJoystickPlayerIndex")
-	// purego.RegisterLibFunc(&sdlSetJoystickVirtualAxis, lib, "SDL_SetJoystickVirtualAxis")
-	// purego.RegisterLibFunc(&sdlSetJoystickVirtualBall, lib, "SDL_SetJoystickVirtualBall")
-	// purego.RegisterLibFunc(&sdlSetJoystickVirtualButton, lib, "SDL_SetJoystickVirtualButton")
-	// purego.RegisterLibFunc(&sdlSetJoystickVirtualHat, lib, "SDL_SetJoystickVirtualHat")
-	// purego.RegisterLibFunc(&sdlSetJoystickVirtualTouchpad, lib, "SDL_SetJoystickVirtualTouchpad")
+	purego.RegisterLibFunc(&sdlSetJoystickVirtualAxis, lib, "SDL_SetJoystickVirtualAxis")
+	purego.RegisterLibFunc(&sdlSetJoystickVirtualBall, lib, "SDL_SetJoystickVirtualBall")
+	purego.RegisterLibFunc(&sdlSetJoystickVirtualButton, lib, "SDL_SetJoystickVirtualButton")
+	purego.RegisterLibFunc(&sdlSetJoystickVirtualHat, lib, "SDL_SetJoystickVirtualHat")
+	purego.RegisterLibFunc(&sdlSetJoystickVirtualTouchpad, lib, "SDL_SetJoystickVirtualTouchpad")
 	// purego.RegisterLibFunc(&sdlSetLinuxThreadPriority, lib, "SDL_SetLinuxThreadPriority")
 	// purego.RegisterLibFunc(&sdlSetLinuxThreadPriorityAndPolicy, lib, "SDL_SetLinuxThreadPriorityAndPolicy")
 	// purego.RegisterLibFunc(&sdlSetLogOutputFunction, lib, "SDL_SetLogOutputFunction")
@@ -2284,7 +2284,7 @@ func init() {
 	purego.RegisterLibFunc(&sdlShowSimpleMessageBox, lib, "SDL_ShowSimpleMessageBox")
 	purego.RegisterLibFunc(&sdlShowWindow, lib, "SDL_ShowWindow")
 	// purego.RegisterLibFunc(&sdlShowWindowSystemMenu, lib, "SDL_ShowWindowSystemMenu")
-	// purego.RegisterLibFunc(&sdlSignalAsyncIOQueue, lib, "SDL_SignalAsyncIOQueue")
+	purego.RegisterLibFunc(&sdlSignalAsyncIOQueue, lib, "SDL_SignalAsyncIOQueue")
 	// purego.RegisterLibFunc(&sdlSignalCondition, lib, "SDL_SignalCondition")
 	// purego.RegisterLibFunc(&sdlSignalSemaphore, lib, "SDL_SignalSemaphore")
 	// purego.RegisterLibFunc(&sdlsin, lib, "SDL_sin")
@@ -2302,11 +2302,11 @@ func init() {
 	purego.RegisterLibFunc(&sdlStartTextInputWithProperties, lib, "SDL_StartTextInputWithProperties")
 	// purego.RegisterLibFunc(&sdlStepBackUTF8, lib, "SDL_StepBackUTF8")
 	// purego.RegisterLibFunc(&sdlStepUTF8, lib, "SDL_StepUTF8")
-	// purego.RegisterLibFunc(&sdlStopHapticEffect, lib, "SDL_StopHapticEffect")
-	// purego.RegisterLibFunc(&sdlStopHapticEffects, lib, "SDL_StopHapticEffects")
-	// purego.RegisterLibFunc(&sdlStopHapticRumble, lib, "SDL_StopHapticRumble")
+	purego.RegisterLibFunc(&sdlStopHapticEffect, lib, "SDL_StopHapticEffect")
+	purego.RegisterLibFunc(&sdlStopHapticEffects, lib, "SDL_StopHapticEffects")
+	purego.RegisterLibFunc(&sdlStopHapticRumble, lib, "SDL_StopHapticRumble")
 	purego.RegisterLibFunc(&sdlStopTextInput, lib, "SDL_StopTextInput")
-	// purego.RegisterLibFunc(&sdlStorageReady, lib, "SDL_StorageReady")
+	purego.RegisterLibFunc(&sdlStorageReady, lib, "SDL_StorageReady")
 	// purego.RegisterLibFunc(&sdlstrcasecmp, lib, "SDL_strcasecmp")
 	// purego.RegisterLibFunc(&sdlstrcasestr, lib, "SDL_strcasestr")
 	// purego.RegisterLibFunc(&sdlstrchr, lib, "SDL_strchr")
@@ -2343,7 +2343,7 @@ func init() {
 	purego.RegisterLibFunc(&sdlSyncWindow, lib, "SDL_SyncWindow")
 	// purego.RegisterLibFunc(&sdltan, lib, "SDL_tan")
 	// purego.RegisterLibFunc(&sdltanf, lib, "SDL_tanf")
-	// purego.RegisterLibFunc(&sdlTellIO, lib, "SDL_TellIO")
+	purego.RegisterLibFunc(&sdlTellIO, lib, "SDL_TellIO")
 	purego.RegisterLibFunc(&sdlTextInputActive, lib, "SDL_TextInputActive")
 	// purego.RegisterLibFunc(&sdlTimeFromWindows, lib, "SDL_TimeFromWindows")
 	// purego.RegisterLibFunc(&sdlTimeToDateTime, lib, "SDL_TimeToDateTime")
@@ -2374,12 +2374,12 @@ func init() {
 	purego.RegisterLibFunc(&sdlUnlockTexture, lib, "SDL_UnlockTexture")
 	purego.RegisterLibFunc(&sdlUnmapGPUTransferBuffer, lib, "SDL_UnmapGPUTransferBuffer")
 	// purego.RegisterLibFunc(&sdlunsetenv_unsafe, lib, "SDL_unsetenv_unsafe")
-	// purego.RegisterLibFunc(&sdlUnsetEnvironmentVariable, lib, "SDL_UnsetEnvironmentVariable")
-	// purego.RegisterLibFunc(&sdlUpdateGamepads, lib, "SDL_UpdateGamepads")
-	// purego.RegisterLibFunc(&sdlUpdateHapticEffect, lib, "SDL_UpdateHapticEffect")
+	purego.RegisterLibFunc(&sdlUnsetEnvironmentVariable, lib, "SDL_UnsetEnvironmentVariable")
+	purego.RegisterLibFunc(&sdlUpdateGamepads, lib, "SDL_UpdateGamepads")
+	purego.RegisterLibFunc(&sdlUpdateHapticEffect, lib, "SDL_UpdateHapticEffect")
 	purego.RegisterLibFunc(&sdlUpdateJoysticks, lib, "SDL_UpdateJoysticks")
 	sdlUpdateNVTexture = shared.Get(lib, "SDL_UpdateNVTexture")
-	// purego.RegisterLibFunc(&sdlUpdateSensors, lib, "SDL_UpdateSensors")
+	purego.RegisterLibFunc(&sdlUpdateSensors, lib, "SDL_UpdateSensors")
 	sdlUpdateTexture = shared.Get(lib, "SDL_UpdateTexture")
 	purego.RegisterLibFunc(&sdlUpdateWindowSurface, lib, "SDL_UpdateWindowSurface")
 	// purego.RegisterLibFunc(&sdlUpdateWindowSurfaceRects, lib, "SDL_UpdateWindowSurfaceRects")
@@ -2394,7 +2394,7 @@ func init() {
 	// purego.RegisterLibFunc(&sdlvsscanf, lib, "SDL_vsscanf")
 	// purego.RegisterLibFunc(&sdlvswprintf, lib, "SDL_vswprintf")
 	purego.RegisterLibFunc(&sdlWaitAndAcquireGPUSwapchainTexture, lib, "SDL_WaitAndAcquireGPUSwapchainTexture")
-	// purego.RegisterLibFunc(&sdlWaitAsyncIOResult, lib, "SDL_WaitAsyncIOResult")
+	purego.RegisterLibFunc(&sdlWaitAsyncIOResult, lib, "SDL_WaitAsyncIOResult")
 	// purego.RegisterLibFunc(&sdlWaitCondition, lib, "SDL_WaitCondition")
 	// purego.RegisterLibFunc(&sdlWaitConditionTimeout, lib, "SDL_WaitConditionTimeout")
 	purego.RegisterLibFunc(&sdlWaitEvent, lib, "SDL_WaitEvent")
@@ -2402,7 +2402,7 @@ func init() {
 	purego.RegisterLibFunc(&sdlWaitForGPUFences, lib, "SDL_WaitForGPUFences")
 	purego.RegisterLibFunc(&sdlWaitForGPUIdle, lib, "SDL_WaitForGPUIdle")
 	purego.RegisterLibFunc(&sdlWaitForGPUSwapchain, lib, "SDL_WaitForGPUSwapchain")
-	// purego.RegisterLibFunc(&sdlWaitProcess, lib, "SDL_WaitProcess")
+	purego.RegisterLibFunc(&sdlWaitProcess, lib, "SDL_WaitProcess")
 	// purego.RegisterLibFunc(&sdlWaitSemaphore, lib, "SDL_WaitSemaphore")
 	// purego.RegisterLibFunc(&sdlWaitSemaphoreTimeout, lib, "SDL_WaitSemaphoreTimeout")
 	// purego.RegisterLibFunc(&sdlWaitThread, lib, "SDL_WaitThread")
@@ -2424,8 +2424,8 @@ func init() {
 	// purego.RegisterLibFunc(&sdlWindowHasSurface, lib, "SDL_WindowHasSurface")
 	purego.RegisterLibFunc(&sdlWindowSupportsGPUPresentMode, lib, "SDL_WindowSupportsGPUPresentMode")
 	// purego.RegisterLibFunc(&sdlWindowSupportsGPUSwapchainComposition, lib, "SDL_WindowSupportsGPUSwapchainComposition")
-	// purego.RegisterLibFunc(&sdlWriteAsyncIO, lib, "SDL_WriteAsyncIO")
-	// purego.RegisterLibFunc(&sdlWriteIO, lib, "SDL_WriteIO")
+	purego.RegisterLibFunc(&sdlWriteAsyncIO, lib, "SDL_WriteAsyncIO")
+	purego.RegisterLibFunc(&sdlWriteIO, lib, "SDL_WriteIO")
 	// purego.RegisterLibFunc(&sdlWriteS16BE, lib, "SDL_WriteS16BE")
 	// purego.RegisterLibFunc(&sdlWriteS16LE, lib, "SDL_WriteS16LE")
 	// purego.RegisterLibFunc(&sdlWriteS32BE, lib, "SDL_WriteS32BE")
@@ -2433,7 +2433,7 @@ func init() {
 	// purego.RegisterLibFunc(&sdlWriteS64BE, lib, "SDL_WriteS64BE")
 	// purego.RegisterLibFunc(&sdlWriteS64LE, lib, "SDL_WriteS64LE")
 	// purego.RegisterLibFunc(&sdlWriteS8, lib, "SDL_WriteS8")
-	// purego.RegisterLibFunc(&sdlWriteStorageFile, lib, "SDL_WriteStorageFile")
+	purego.RegisterLibFunc(&sdlWriteStorageFile, lib, "SDL_WriteStorageFile")
 	// purego.RegisterLibFunc(&sdlWriteSurfacePixel, lib, "SDL_WriteSurfacePixel")
 	// purego.RegisterLibFunc(&sdlWriteSurfacePixelFloat, lib, "SDL_WriteSurfacePixelFloat")
 	// purego.RegisterLibFunc(&sdlWriteU16BE, lib, "SDL_WriteU16BE")
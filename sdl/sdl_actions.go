@@ -0,0 +1,724 @@
+package sdl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// InputKind is the kind of device input an [Input] refers to.
+type InputKind uint8
+
+const (
+	InputNone InputKind = iota
+	InputKey
+	InputMouseButton
+	InputGamepadButton
+	InputGamepadAxis
+	InputJoystickButton
+	InputJoystickAxis
+	InputJoystickHat
+)
+
+var inputKindNames = [...]string{
+	InputNone:           "none",
+	InputKey:            "key",
+	InputMouseButton:    "mouse",
+	InputGamepadButton:  "gamepad_button",
+	InputGamepadAxis:    "gamepad_axis",
+	InputJoystickButton: "joystick_button",
+	InputJoystickAxis:   "joystick_axis",
+	InputJoystickHat:    "joystick_hat",
+}
+
+func (k InputKind) String() string {
+	if int(k) < len(inputKindNames) {
+		return inputKindNames[k]
+	}
+	return "unknown"
+}
+
+// Input is a single physical input, like a key, a mouse button or one direction of a gamepad stick.
+type Input struct {
+	Kind InputKind
+	// Index is the scancode, mouse button, gamepad button/axis or joystick button/axis/hat index.
+	Index int32
+	// Direction restricts an axis to one half: 1 for the positive half, -1 for the negative half and 0 for the full axis.
+	Direction int8
+	// HatMask is the hat position (e.g. [HatUp]) required for [InputJoystickHat].
+	HatMask uint8
+}
+
+// KeyInput returns the input for a keyboard key.
+func KeyInput(scancode Scancode) Input {
+	return Input{Kind: InputKey, Index: int32(scancode)}
+}
+
+// MouseButtonInput returns the input for a mouse button (see [ButtonLeft] etc.).
+func MouseButtonInput(button uint8) Input {
+	return Input{Kind: InputMouseButton, Index: int32(button)}
+}
+
+// GamepadButtonInput returns the input for a gamepad button.
+func GamepadButtonInput(button GamepadButton) Input {
+	return Input{Kind: InputGamepadButton, Index: int32(button)}
+}
+
+// GamepadAxisInput returns the input for a gamepad axis. Use a direction of 0 for the full axis,
+// or 1 and -1 to use one half of the axis like a button.
+func GamepadAxisInput(axis GamepadAxis, direction int8) Input {
+	return Input{Kind: InputGamepadAxis, Index: int32(axis), Direction: direction}
+}
+
+// JoystickButtonInput returns the input for a joystick button.
+func JoystickButtonInput(button uint8) Input {
+	return Input{Kind: InputJoystickButton, Index: int32(button)}
+}
+
+// JoystickAxisInput returns the input for a joystick axis. See [GamepadAxisInput] for the meaning of direction.
+func JoystickAxisInput(axis uint8, direction int8) Input {
+	return Input{Kind: InputJoystickAxis, Index: int32(axis), Direction: direction}
+}
+
+// JoystickHatInput returns the input for a joystick hat position like [HatUp].
+func JoystickHatInput(hat uint8, mask uint8) Input {
+	return Input{Kind: InputJoystickHat, Index: int32(hat), HatMask: mask}
+}
+
+// String returns the textual form used by [Input.MarshalText], e.g. "key:Left Ctrl", "gamepad_axis:0+" or "joystick_hat:0:1".
+func (i Input) String() string {
+	var sb strings.Builder
+	sb.WriteString(i.Kind.String())
+	sb.WriteByte(':')
+	if i.Kind == InputKey {
+		name, _ := Scancode(i.Index).MarshalText()
+		sb.Write(name)
+		return sb.String()
+	}
+	sb.WriteString(strconv.Itoa(int(i.Index)))
+	switch i.Kind {
+	case InputGamepadAxis, InputJoystickAxis:
+		if i.Direction > 0 {
+			sb.WriteByte('+')
+		} else if i.Direction < 0 {
+			sb.WriteByte('-')
+		}
+	case InputJoystickHat:
+		sb.WriteByte(':')
+		sb.WriteString(strconv.Itoa(int(i.HatMask)))
+	}
+	return sb.String()
+}
+
+func (i Input) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *Input) UnmarshalText(text []byte) error {
+	parsed, err := ParseInput(string(text))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// ParseInput parses the textual form produced by [Input.String]. Keys are parsed with [ParseScancode].
+func ParseInput(s string) (Input, error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) < 2 {
+		return Input{}, fmt.Errorf("sdl: invalid input %q", s)
+	}
+
+	var input Input
+	for kind, name := range inputKindNames {
+		if name == parts[0] {
+			input.Kind = InputKind(kind)
+			break
+		}
+	}
+	if input.Kind == InputNone {
+		return Input{}, fmt.Errorf("sdl: unknown input kind %q", parts[0])
+	}
+
+	index := parts[1]
+	switch input.Kind {
+	case InputKey:
+		scancode, err := ParseScancode(index)
+		if err != nil {
+			return Input{}, err
+		}
+		input.Index = int32(scancode)
+		return input, nil
+	case InputGamepadAxis, InputJoystickAxis:
+		if strings.HasSuffix(index, "+") {
+			input.Direction = 1
+			index = index[:len(index)-1]
+		} else if strings.HasSuffix(index, "-") {
+			input.Direction = -1
+			index = index[:len(index)-1]
+		}
+	case InputJoystickHat:
+		hat := strings.Split(index, ":")
+		if len(hat) != 2 {
+			return Input{}, fmt.Errorf("sdl: invalid hat input %q", s)
+		}
+		mask, err := strconv.ParseUint(hat[1], 10, 8)
+		if err != nil {
+			return Input{}, fmt.Errorf("sdl: invalid hat mask in %q: %w", s, err)
+		}
+		input.HatMask = uint8(mask)
+		index = hat[0]
+	}
+
+	n, err := strconv.ParseInt(index, 10, 32)
+	if err != nil {
+		return Input{}, fmt.Errorf("sdl: invalid index in %q: %w", s, err)
+	}
+	input.Index = int32(n)
+	return input, nil
+}
+
+// Binding binds an action to one input or to a chord of several inputs.
+//
+// A chord is active while all of its inputs are active and its last input was activated after the others,
+// e.g. holding [ScancodeLCtrl] and then pressing [ScancodeS]. Pressing S first and Ctrl afterwards doesn't
+// activate the chord. The value of a chord is the value of its last input.
+//
+// While a chord is active, it suppresses the bindings of all actions whose inputs are part of the chord,
+// so holding Ctrl+S triggers a "save" action bound to the chord, but not a "move_down" action bound to S alone.
+type Binding struct {
+	Inputs []Input `json:"inputs"`
+	// Scale is multiplied with the input value. Use -1 to bind e.g. a key to the negative side of an axis action.
+	// A scale of 0 is treated as 1.
+	Scale float32 `json:"scale,omitempty"`
+	// DeadZone overrides [ActionMap.DeadZone] for axis inputs if it is greater than 0.
+	DeadZone float32 `json:"dead_zone,omitempty"`
+}
+
+// NewBinding creates a binding for a single input or a chord.
+func NewBinding(inputs ...Input) Binding {
+	return Binding{Inputs: inputs}
+}
+
+// WithScale returns a copy of the binding with the given scale.
+func (b Binding) WithScale(scale float32) Binding {
+	b.Scale = scale
+	return b
+}
+
+type inputKey struct {
+	kind  InputKind
+	which JoystickID
+	index int32
+}
+
+// activation identifies a binding input together with the dead zone it is evaluated with.
+type activation struct {
+	input    Input
+	deadZone float32
+}
+
+type actionState struct {
+	bindings []Binding
+	value    float32
+	previous float32
+}
+
+// ActionMap maps named actions like "jump" or "move_x" to keyboard, mouse, gamepad and joystick inputs.
+//
+// Feed every event to [ActionMap.HandleEvent] and call [ActionMap.Update] once per frame after all events have been handled.
+// Digital inputs have a value of 0 or 1, axes are normalized to -1...1 (triggers to 0...1) and respect the dead zone.
+//
+// The zero value is an empty action map without dead zone and with a press threshold of 0.5.
+type ActionMap struct {
+	// DeadZone is the default dead zone for axis inputs in the range 0...1.
+	DeadZone float32
+	// PressThreshold is the absolute value at which an action counts as pressed. 0 means 0.5.
+	PressThreshold float32
+	// Device restricts gamepad and joystick inputs to one device. 0 accepts input from every device.
+	Device JoystickID
+
+	actions map[string]*actionState
+	inputs  map[inputKey]float32
+	// activated holds the order in which the active binding inputs were activated, to check the order of chords.
+	activated map[activation]uint64
+	sequence  uint64
+
+	rebinding    bool
+	rebindAction string
+	rebindIndex  int
+	rebindDone   func(action string, binding Binding)
+}
+
+// NewActionMap creates an empty action map with a dead zone of 0.15 and a press threshold of 0.5.
+func NewActionMap() *ActionMap {
+	return &ActionMap{
+		DeadZone:       0.15,
+		PressThreshold: 0.5,
+		actions:        make(map[string]*actionState),
+		inputs:         make(map[inputKey]float32),
+		activated:      make(map[activation]uint64),
+	}
+}
+
+// init creates the maps of a zero value.
+func (m *ActionMap) init() {
+	if m.actions == nil {
+		m.actions = make(map[string]*actionState)
+	}
+	if m.inputs == nil {
+		m.inputs = make(map[inputKey]float32)
+		m.activated = make(map[activation]uint64)
+	}
+}
+
+func (m *ActionMap) action(name string) *actionState {
+	m.init()
+	action, ok := m.actions[name]
+	if !ok {
+		action = &actionState{}
+		m.actions[name] = action
+	}
+	return action
+}
+
+// Bind adds bindings to an action, creating the action if necessary.
+func (m *ActionMap) Bind(action string, bindings ...Binding) {
+	a := m.action(action)
+	a.bindings = append(a.bindings, bindings...)
+}
+
+// Rebind replaces all bindings of an action.
+func (m *ActionMap) Rebind(action string, bindings ...Binding) {
+	m.action(action).bindings = append([]Binding(nil), bindings...)
+}
+
+// Unbind removes an action and all of its bindings.
+func (m *ActionMap) Unbind(action string) {
+	delete(m.actions, action)
+}
+
+// Bindings returns the bindings of an action.
+func (m *ActionMap) Bindings(action string) []Binding {
+	a, ok := m.actions[action]
+	if !ok {
+		return nil
+	}
+	return append([]Binding(nil), a.bindings...)
+}
+
+// Actions returns the names of all actions in alphabetical order.
+func (m *ActionMap) Actions() []string {
+	names := make([]string, 0, len(m.actions))
+	for name := range m.actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Value returns the current value of an action in the range -1...1.
+func (m *ActionMap) Value(action string) float32 {
+	if a, ok := m.actions[action]; ok {
+		return a.value
+	}
+	return 0
+}
+
+// Pressed reports whether the action is held down.
+func (m *ActionMap) Pressed(action string) bool {
+	return m.pressed(m.Value(action))
+}
+
+// JustPressed reports whether the action got pressed since the previous call to [ActionMap.Update].
+func (m *ActionMap) JustPressed(action string) bool {
+	a, ok := m.actions[action]
+	return ok && m.pressed(a.value) && !m.pressed(a.previous)
+}
+
+// JustReleased reports whether the action got released since the previous call to [ActionMap.Update].
+func (m *ActionMap) JustReleased(action string) bool {
+	a, ok := m.actions[action]
+	return ok && !m.pressed(a.value) && m.pressed(a.previous)
+}
+
+func (m *ActionMap) pressed(value float32) bool {
+	threshold := m.PressThreshold
+	if threshold <= 0 {
+		threshold = 0.5
+	}
+	return float32(math.Abs(float64(value))) >= threshold
+}
+
+// Update marks the end of a frame. The values of [ActionMap.JustPressed] and [ActionMap.JustReleased] are relative to the previous call.
+func (m *ActionMap) Update() {
+	for _, a := range m.actions {
+		a.previous = a.value
+	}
+}
+
+// Reset releases all inputs, e.g. after the window lost focus.
+func (m *ActionMap) Reset() {
+	m.inputs = make(map[inputKey]float32)
+	m.activated = make(map[activation]uint64)
+	m.refresh()
+}
+
+// BeginRebind captures the next input and uses it as the binding at the given index of an action.
+// An index out of range appends a new binding. The optional done function is called once the binding has been captured.
+// While a rebind is in progress, events do not trigger any actions and all inputs are released.
+func (m *ActionMap) BeginRebind(action string, index int, done func(action string, binding Binding)) {
+	m.Reset()
+	m.rebinding = true
+	m.rebindAction = action
+	m.rebindIndex = index
+	m.rebindDone = done
+}
+
+// CancelRebind stops a rebind started with [ActionMap.BeginRebind] without changing any binding.
+func (m *ActionMap) CancelRebind() {
+	m.rebinding = false
+	m.rebindDone = nil
+}
+
+// Rebinding reports whether a rebind is in progress.
+func (m *ActionMap) Rebinding() bool {
+	return m.rebinding
+}
+
+// CaptureInput returns the input that got activated by an event, which is useful to implement rebinding menus.
+// Axis motion is only reported once it crosses half of its range.
+func CaptureInput(event *Event) (Input, bool) {
+	const threshold = 16384
+	switch event.Type() {
+	case EventKeyDown:
+		if key := event.Key(); !key.Repeat {
+			return KeyInput(key.Scancode), true
+		}
+	case EventMouseButtonDown:
+		return MouseButtonInput(event.Button().Button), true
+	case EventGamepadButtonDown:
+		return GamepadButtonInput(GamepadButton(event.GButton().Button)), true
+	case EventGamepadAxisMotion:
+		axis := event.GAxis()
+		if axis.Value >= threshold {
+			return GamepadAxisInput(GamepadAxis(axis.Axis), 1), true
+		} else if axis.Value <= -threshold {
+			return GamepadAxisInput(GamepadAxis(axis.Axis), -1), true
+		}
+	case EventJoystickButtonDown:
+		return JoystickButtonInput(event.JButton().Button), true
+	case EventJoystickAxisMotion:
+		axis := event.JAxis()
+		if axis.Value >= threshold {
+			return JoystickAxisInput(axis.Axis, 1), true
+		} else if axis.Value <= -threshold {
+			return JoystickAxisInput(axis.Axis, -1), true
+		}
+	case EventJoystickHatMotion:
+		hat := event.JHat()
+		if hat.Value != HatCentered {
+			return JoystickHatInput(hat.Hat, hat.Value), true
+		}
+	}
+	return Input{}, false
+}
+
+// HandleEvent updates the input state from an event.
+func (m *ActionMap) HandleEvent(event *Event) {
+	if m.rebinding {
+		if input, ok := CaptureInput(event); ok {
+			m.finishRebind(input)
+		}
+		return
+	}
+
+	m.init()
+	switch event.Type() {
+	case EventKeyDown, EventKeyUp:
+		key := event.Key()
+		m.set(inputKey{InputKey, 0, int32(key.Scancode)}, key.Down)
+	case EventMouseButtonDown, EventMouseButtonUp:
+		button := event.Button()
+		m.set(inputKey{InputMouseButton, 0, int32(button.Button)}, button.Down)
+	case EventGamepadButtonDown, EventGamepadButtonUp:
+		button := event.GButton()
+		if m.accepts(button.Which) {
+			m.set(inputKey{InputGamepadButton, button.Which, int32(button.Button)}, button.Down)
+		}
+	case EventGamepadAxisMotion:
+		axis := event.GAxis()
+		if m.accepts(axis.Which) {
+			m.setAxis(inputKey{InputGamepadAxis, axis.Which, int32(axis.Axis)}, axis.Value)
+		}
+	case EventJoystickButtonDown, EventJoystickButtonUp:
+		button := event.JButton()
+		if m.accepts(button.Which) {
+			m.set(inputKey{InputJoystickButton, button.Which, int32(button.Button)}, button.Down)
+		}
+	case EventJoystickAxisMotion:
+		axis := event.JAxis()
+		if m.accepts(axis.Which) {
+			m.setAxis(inputKey{InputJoystickAxis, axis.Which, int32(axis.Axis)}, axis.Value)
+		}
+	case EventJoystickHatMotion:
+		hat := event.JHat()
+		if m.accepts(hat.Which) {
+			m.inputs[inputKey{InputJoystickHat, hat.Which, int32(hat.Hat)}] = float32(hat.Value)
+			m.refresh()
+		}
+	case EventGamepadRemoved, EventJoystickRemoved:
+		which := event.JDevice().Which
+		for key := range m.inputs {
+			if key.which == which && key.kind >= InputGamepadButton {
+				delete(m.inputs, key)
+			}
+		}
+		m.refresh()
+	case EventWindowFocusLost:
+		m.Reset()
+	}
+}
+
+func (m *ActionMap) finishRebind(input Input) {
+	a := m.action(m.rebindAction)
+	binding := Binding{Inputs: []Input{input}}
+	if m.rebindIndex >= 0 && m.rebindIndex < len(a.bindings) {
+		binding.Scale = a.bindings[m.rebindIndex].Scale
+		binding.DeadZone = a.bindings[m.rebindIndex].DeadZone
+		a.bindings[m.rebindIndex] = binding
+	} else {
+		a.bindings = append(a.bindings, binding)
+	}
+	done, action := m.rebindDone, m.rebindAction
+	m.CancelRebind()
+	if done != nil {
+		done(action, binding)
+	}
+}
+
+func (m *ActionMap) accepts(which JoystickID) bool {
+	return m.Device == 0 || m.Device == which
+}
+
+func (m *ActionMap) set(key inputKey, down bool) {
+	if down {
+		m.inputs[key] = 1
+	} else {
+		delete(m.inputs, key)
+	}
+	m.refresh()
+}
+
+func (m *ActionMap) setAxis(key inputKey, value int16) {
+	m.inputs[key] = float32(value) / 32767
+	m.refresh()
+}
+
+// deadZone returns the dead zone of a binding.
+func (m *ActionMap) deadZone(b Binding) float32 {
+	if b.DeadZone > 0 {
+		return b.DeadZone
+	}
+	return m.DeadZone
+}
+
+// refresh recalculates the value of every action from the current input state.
+func (m *ActionMap) refresh() {
+	// record when the binding inputs became active, inputs activated by the same event share a sequence number
+	m.sequence++
+	activated := make(map[activation]uint64)
+	for _, a := range m.actions {
+		for _, b := range a.bindings {
+			deadZone := m.deadZone(b)
+			for _, input := range b.Inputs {
+				if m.inputValue(input, deadZone) == 0 {
+					continue
+				}
+				key := activation{input, deadZone}
+				if sequence, ok := m.activated[key]; ok {
+					activated[key] = sequence
+				} else {
+					activated[key] = m.sequence
+				}
+			}
+		}
+	}
+	m.activated = activated
+
+	// collect the active chords first, they suppress the bindings they contain
+	var chords []Binding
+	for _, a := range m.actions {
+		for _, b := range a.bindings {
+			if len(b.Inputs) > 1 && m.bindingValue(b) != 0 {
+				chords = append(chords, b)
+			}
+		}
+	}
+
+	for _, a := range m.actions {
+		var value float32
+		for _, b := range a.bindings {
+			if !suppressed(b, chords) {
+				value += m.bindingValue(b)
+			}
+		}
+		a.value = float32(math.Max(-1, math.Min(1, float64(value))))
+	}
+}
+
+// suppressed reports whether an active chord contains all inputs of b and more.
+func suppressed(b Binding, chords []Binding) bool {
+	for _, chord := range chords {
+		if len(chord.Inputs) > len(b.Inputs) && containsInputs(chord.Inputs, b.Inputs) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInputs(set, inputs []Input) bool {
+	for _, input := range inputs {
+		found := false
+		for _, other := range set {
+			if other == input {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *ActionMap) bindingValue(b Binding) float32 {
+	if len(b.Inputs) == 0 {
+		return 0
+	}
+	deadZone := m.deadZone(b)
+	last := b.Inputs[len(b.Inputs)-1]
+	lastActivated := m.activated[activation{last, deadZone}]
+	for _, input := range b.Inputs[:len(b.Inputs)-1] {
+		if m.inputValue(input, deadZone) == 0 {
+			return 0
+		}
+		// the other inputs of a chord have to be active before the last one
+		if m.activated[activation{input, deadZone}] > lastActivated {
+			return 0
+		}
+	}
+	scale := b.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	return m.inputValue(last, deadZone) * scale
+}
+
+// inputValue returns the value of an input with the largest magnitude across all devices.
+func (m *ActionMap) inputValue(input Input, deadZone float32) float32 {
+	var result float32
+	for key, raw := range m.inputs {
+		if key.kind != input.Kind || key.index != input.Index {
+			continue
+		}
+		var value float32
+		switch input.Kind {
+		case InputGamepadAxis, InputJoystickAxis:
+			value = applyDeadZone(raw, deadZone)
+			if input.Direction > 0 {
+				value = float32(math.Max(0, float64(value)))
+			} else if input.Direction < 0 {
+				value = float32(math.Max(0, float64(-value)))
+			}
+		case InputJoystickHat:
+			if input.HatMask != 0 && uint8(raw)&input.HatMask == input.HatMask {
+				value = 1
+			}
+		default:
+			value = raw
+		}
+		if math.Abs(float64(value)) > math.Abs(float64(result)) {
+			result = value
+		}
+	}
+	return result
+}
+
+// applyDeadZone zeroes values inside the dead zone and rescales the remaining range to 0...1.
+func applyDeadZone(value, deadZone float32) float32 {
+	magnitude := float32(math.Min(1, math.Abs(float64(value))))
+	if magnitude <= deadZone {
+		return 0
+	}
+	scaled := (magnitude - deadZone) / (1 - deadZone)
+	if value < 0 {
+		return -scaled
+	}
+	return scaled
+}
+
+// Save writes all bindings as JSON.
+func (m *ActionMap) Save(w io.Writer) error {
+	bindings := make(map[string][]Binding, len(m.actions))
+	for name, a := range m.actions {
+		bindings[name] = a.bindings
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(bindings)
+}
+
+// Load replaces the bindings of all actions contained in the JSON written by [ActionMap.Save].
+// Actions missing from the input keep their bindings.
+func (m *ActionMap) Load(r io.Reader) error {
+	var bindings map[string][]Binding
+	if err := json.NewDecoder(r).Decode(&bindings); err != nil {
+		return err
+	}
+	for name, b := range bindings {
+		m.Rebind(name, b...)
+	}
+	m.refresh()
+	return nil
+}
+
+// SaveFile writes all bindings to a file in the preference directory returned by [GetPrefPath].
+func (m *ActionMap) SaveFile(org, app, name string) error {
+	dir := GetPrefPath(org, app)
+	if dir == "" {
+		return errors.New(GetError())
+	}
+	file, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+	if err := m.Save(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// LoadFile reads bindings from a file in the preference directory returned by [GetPrefPath].
+func (m *ActionMap) LoadFile(org, app, name string) error {
+	dir := GetPrefPath(org, app)
+	if dir == "" {
+		return errors.New(GetError())
+	}
+	file, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return m.Load(file)
+}
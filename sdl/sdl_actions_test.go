@@ -0,0 +1,132 @@
+package sdl
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func keyEvent(scancode Scancode, down bool) *Event {
+	var event Event
+	eventType := EventKeyUp
+	if down {
+		eventType = EventKeyDown
+	}
+	*(*KeyboardEvent)(unsafe.Pointer(&event)) = KeyboardEvent{
+		CommonEvent: CommonEvent{Type: eventType},
+		Scancode:    scancode,
+		Down:        down,
+	}
+	return &event
+}
+
+func axisEvent(axis GamepadAxis, value int16) *Event {
+	var event Event
+	*(*GamepadAxisEvent)(unsafe.Pointer(&event)) = GamepadAxisEvent{
+		CommonEvent: CommonEvent{Type: EventGamepadAxisMotion},
+		Which:       1,
+		Axis:        uint8(axis),
+		Value:       value,
+	}
+	return &event
+}
+
+func TestActionMapZeroValue(t *testing.T) {
+	var m ActionMap
+	m.Bind("jump", NewBinding(KeyInput(ScancodeSpace)))
+	m.HandleEvent(keyEvent(ScancodeSpace, true))
+	if !m.Pressed("jump") {
+		t.Error("jump isn't pressed")
+	}
+	if m.Pressed("unbound") {
+		t.Error("an unbound action is pressed")
+	}
+
+	var empty ActionMap
+	empty.Reset()
+	if empty.Pressed("jump") || len(empty.Actions()) != 0 {
+		t.Error("the zero value isn't empty")
+	}
+}
+
+func TestActionMapChord(t *testing.T) {
+	m := NewActionMap()
+	m.Bind("save", NewBinding(KeyInput(ScancodeLCtrl), KeyInput(ScancodeS)))
+	m.Bind("down", NewBinding(KeyInput(ScancodeS)))
+
+	m.HandleEvent(keyEvent(ScancodeS, true))
+	if !m.Pressed("down") || m.Pressed("save") {
+		t.Errorf("S: down = %v, save = %v, want only down", m.Pressed("down"), m.Pressed("save"))
+	}
+	m.HandleEvent(keyEvent(ScancodeS, false))
+
+	m.HandleEvent(keyEvent(ScancodeLCtrl, true))
+	m.HandleEvent(keyEvent(ScancodeS, true))
+	if !m.Pressed("save") || m.Pressed("down") {
+		t.Errorf("Ctrl+S: down = %v, save = %v, want only save", m.Pressed("down"), m.Pressed("save"))
+	}
+
+	// releasing Ctrl ends the chord and S counts on its own again
+	m.HandleEvent(keyEvent(ScancodeLCtrl, false))
+	if m.Pressed("save") || !m.Pressed("down") {
+		t.Errorf("S after Ctrl+S: down = %v, save = %v, want only down", m.Pressed("down"), m.Pressed("save"))
+	}
+}
+
+func TestActionMapChordOrder(t *testing.T) {
+	m := NewActionMap()
+	m.Bind("save", NewBinding(KeyInput(ScancodeLCtrl), KeyInput(ScancodeS)))
+
+	m.HandleEvent(keyEvent(ScancodeS, true))
+	m.HandleEvent(keyEvent(ScancodeLCtrl, true))
+	if m.Pressed("save") {
+		t.Error("S followed by Ctrl activated the chord")
+	}
+
+	m.HandleEvent(keyEvent(ScancodeS, false))
+	m.HandleEvent(keyEvent(ScancodeS, true))
+	if !m.Pressed("save") {
+		t.Error("pressing S again while holding Ctrl didn't activate the chord")
+	}
+}
+
+func TestActionMapChordDeadZone(t *testing.T) {
+	m := NewActionMap()
+	m.DeadZone = 0.1
+	m.Bind("boost", Binding{Inputs: []Input{KeyInput(ScancodeLShift), GamepadAxisInput(GamepadAxisRightTrigger, 0)}, DeadZone: 0.5})
+
+	// the trigger is outside of the map's dead zone but not outside of the binding's
+	m.HandleEvent(axisEvent(GamepadAxisRightTrigger, 10000))
+	m.HandleEvent(keyEvent(ScancodeLShift, true))
+	m.HandleEvent(axisEvent(GamepadAxisRightTrigger, 30000))
+	if !m.Pressed("boost") {
+		t.Error("the trigger crossed the binding's dead zone after Shift, but the chord isn't active")
+	}
+}
+
+func TestActionMapRebind(t *testing.T) {
+	m := NewActionMap()
+	m.Bind("jump", NewBinding(KeyInput(ScancodeSpace)))
+	m.Bind("fire", NewBinding(KeyInput(ScancodeF)))
+
+	m.HandleEvent(keyEvent(ScancodeF, true))
+	var captured Binding
+	m.BeginRebind("jump", 0, func(action string, binding Binding) { captured = binding })
+	if m.Pressed("fire") {
+		t.Error("fire is still pressed after BeginRebind")
+	}
+	// releasing a key isn't captured, but mustn't reach the actions either
+	m.HandleEvent(keyEvent(ScancodeF, false))
+	m.HandleEvent(keyEvent(ScancodeF, true))
+	if m.Rebinding() || len(captured.Inputs) != 1 || captured.Inputs[0] != KeyInput(ScancodeF) {
+		t.Fatalf("captured %+v", captured.Inputs)
+	}
+	if m.Pressed("fire") || m.Pressed("jump") {
+		t.Error("the captured key triggered an action")
+	}
+
+	m.HandleEvent(keyEvent(ScancodeF, false))
+	m.HandleEvent(keyEvent(ScancodeF, true))
+	if !m.Pressed("jump") || !m.Pressed("fire") {
+		t.Errorf("after the rebind: jump = %v, fire = %v, want both", m.Pressed("jump"), m.Pressed("fire"))
+	}
+}
@@ -1,5 +1,13 @@
 package sdl
 
+import "unsafe"
+
+// AsyncIO is a file that is read and written asynchronously. See [AsyncQueue] for a Go friendly way to use it.
+type AsyncIO struct{}
+
+// AsyncIOQueue collects the results of asynchronous operations.
+type AsyncIOQueue struct{}
+
 type AsyncIOTaskType uint32
 
 const (
@@ -16,46 +24,96 @@ const (
 	AsyncIOCanceled
 )
 
-// func AsyncIOFromFile(file string, mode string) *AsyncIO {
-//	return sdlAsyncIOFromFile(file, mode)
-// }
+// AsyncIOOutcome is the result of a finished asynchronous operation.
+type AsyncIOOutcome struct {
+	AsyncIO          *AsyncIO
+	Type             AsyncIOTaskType
+	Result           AsyncIOResult
+	Buffer           unsafe.Pointer
+	Offset           uint64
+	BytesRequested   uint64
+	BytesTransferred uint64
+	// Userdata is the value passed when the task was started. It is an integer, because Go pointers
+	// must not be kept by C code, so use it e.g. as key of a map that holds the Go data of the task.
+	Userdata uintptr
+}
+
+// [AsyncIOFromFile] creates a new [AsyncIO] object for reading from and/or writing to a named file.
+//
+// [AsyncIOFromFile]: https://wiki.libsdl.org/SDL3/SDL_AsyncIOFromFile
+func AsyncIOFromFile(file string, mode string) *AsyncIO {
+	return sdlAsyncIOFromFile(file, mode)
+}
 
-// func CloseAsyncIO(asyncio *AsyncIO, flush bool, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
-//	return sdlCloseAsyncIO(asyncio, flush, queue, userdata)
-// }
+// [CloseAsyncIO] closes an [AsyncIO] object. The result is reported to queue.
+//
+// [CloseAsyncIO]: https://wiki.libsdl.org/SDL3/SDL_CloseAsyncIO
+func CloseAsyncIO(asyncio *AsyncIO, flush bool, queue *AsyncIOQueue, userdata uintptr) bool {
+	return sdlCloseAsyncIO(asyncio, flush, queue, userdata)
+}
 
-// func CreateAsyncIOQueue() *AsyncIOQueue {
-//	return sdlCreateAsyncIOQueue()
-// }
+// [CreateAsyncIOQueue] creates a task queue for tracking multiple I/O operations.
+//
+// [CreateAsyncIOQueue]: https://wiki.libsdl.org/SDL3/SDL_CreateAsyncIOQueue
+func CreateAsyncIOQueue() *AsyncIOQueue {
+	return sdlCreateAsyncIOQueue()
+}
 
-// func DestroyAsyncIOQueue(queue *AsyncIOQueue)  {
-//	sdlDestroyAsyncIOQueue(queue)
-// }
+// [DestroyAsyncIOQueue] destroys a task queue. It blocks until all pending tasks are finished and discards their results.
+//
+// [DestroyAsyncIOQueue]: https://wiki.libsdl.org/SDL3/SDL_DestroyAsyncIOQueue
+func DestroyAsyncIOQueue(queue *AsyncIOQueue) {
+	sdlDestroyAsyncIOQueue(queue)
+}
 
-// func GetAsyncIOResult(queue *AsyncIOQueue, outcome *AsyncIOOutcome) bool {
-//	return sdlGetAsyncIOResult(queue, outcome)
-// }
+// [GetAsyncIOResult] queries an async I/O task queue for completed tasks without blocking.
+//
+// [GetAsyncIOResult]: https://wiki.libsdl.org/SDL3/SDL_GetAsyncIOResult
+func GetAsyncIOResult(queue *AsyncIOQueue, outcome *AsyncIOOutcome) bool {
+	return sdlGetAsyncIOResult(queue, outcome)
+}
 
-// func GetAsyncIOSize(asyncio *AsyncIO) int64 {
-//	return sdlGetAsyncIOSize(asyncio)
-// }
+// [GetAsyncIOSize] gets the size of the data stream in an [AsyncIO].
+//
+// [GetAsyncIOSize]: https://wiki.libsdl.org/SDL3/SDL_GetAsyncIOSize
+func GetAsyncIOSize(asyncio *AsyncIO) int64 {
+	return sdlGetAsyncIOSize(asyncio)
+}
 
-// func LoadFileAsync(file string, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
-//	return sdlLoadFileAsync(file, queue, userdata)
-// }
+// [LoadFileAsync] loads all the data from a file path asynchronously. The buffer of the outcome
+// has to be freed with [Free].
+//
+// [LoadFileAsync]: https://wiki.libsdl.org/SDL3/SDL_LoadFileAsync
+func LoadFileAsync(file string, queue *AsyncIOQueue, userdata uintptr) bool {
+	return sdlLoadFileAsync(file, queue, userdata)
+}
 
-// func ReadAsyncIO(asyncio *AsyncIO, ptr unsafe.Pointer, offset uint64, size uint64, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
-//	return sdlReadAsyncIO(asyncio, ptr, offset, size, queue, userdata)
-// }
+// [ReadAsyncIO] starts an async read. ptr must stay valid until the task is finished,
+// so it must not point to Go memory, see [Malloc].
+//
+// [ReadAsyncIO]: https://wiki.libsdl.org/SDL3/SDL_ReadAsyncIO
+func ReadAsyncIO(asyncio *AsyncIO, ptr unsafe.Pointer, offset uint64, size uint64, queue *AsyncIOQueue, userdata uintptr) bool {
+	return sdlReadAsyncIO(asyncio, ptr, offset, size, queue, userdata)
+}
 
-// func SignalAsyncIOQueue(queue *AsyncIOQueue)  {
-//	sdlSignalAsyncIOQueue(queue)
-// }
+// [SignalAsyncIOQueue] wakes up any threads that are blocking in [WaitAsyncIOResult].
+//
+// [SignalAsyncIOQueue]: https://wiki.libsdl.org/SDL3/SDL_SignalAsyncIOQueue
+func SignalAsyncIOQueue(queue *AsyncIOQueue) {
+	sdlSignalAsyncIOQueue(queue)
+}
 
-// func WaitAsyncIOResult(queue *AsyncIOQueue, outcome *AsyncIOOutcome, timeoutMS int32) bool {
-//	return sdlWaitAsyncIOResult(queue, outcome, timeoutMS)
-// }
+// [WaitAsyncIOResult] blocks until an async I/O task queue has a completed task. A timeout of -1 waits indefinitely.
+//
+// [WaitAsyncIOResult]: https://wiki.libsdl.org/SDL3/SDL_WaitAsyncIOResult
+func WaitAsyncIOResult(queue *AsyncIOQueue, outcome *AsyncIOOutcome, timeoutMS int32) bool {
+	return sdlWaitAsyncIOResult(queue, outcome, timeoutMS)
+}
 
-// func WriteAsyncIO(asyncio *AsyncIO, ptr unsafe.Pointer, offset uint64, size uint64, queue *AsyncIOQueue, userdata unsafe.Pointer) bool {
-//	return sdlWriteAsyncIO(asyncio, ptr, offset, size, queue, userdata)
-// }
+// [WriteAsyncIO] starts an async write. ptr must stay valid until the task is finished,
+// so it must not point to Go memory, see [Malloc].
+//
+// [WriteAsyncIO]: https://wiki.libsdl.org/SDL3/SDL_WriteAsyncIO
+func WriteAsyncIO(asyncio *AsyncIO, ptr unsafe.Pointer, offset uint64, size uint64, queue *AsyncIOQueue, userdata uintptr) bool {
+	return sdlWriteAsyncIO(asyncio, ptr, offset, size, queue, userdata)
+}
@@ -0,0 +1,300 @@
+package sdl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+var (
+	// ErrAsyncIOFailed is the error of an asynchronous operation that finished with [AsyncIOFailure].
+	ErrAsyncIOFailed = errors.New("sdl: async I/O failed")
+	// ErrAsyncIOCanceled is the error of an asynchronous operation that finished with [AsyncIOCanceled].
+	ErrAsyncIOCanceled = errors.New("sdl: async I/O was canceled")
+)
+
+// AsyncResult is the result of an operation started on an [AsyncQueue].
+type AsyncResult struct {
+	Type   AsyncIOTaskType
+	Result AsyncIOResult
+	// Data holds the bytes that were read. It is owned by Go and nil for writes and closes.
+	Data             []byte
+	Offset           uint64
+	BytesRequested   uint64
+	BytesTransferred uint64
+	// Err is nil if Result is [AsyncIOComplete].
+	Err error
+}
+
+// AsyncFuture is the pending result of an asynchronous operation. It is completed by
+// [AsyncQueue.Poll] or [AsyncQueue.Run].
+type AsyncFuture struct {
+	done   chan struct{}
+	result AsyncResult
+}
+
+func newAsyncFuture() *AsyncFuture {
+	return &AsyncFuture{done: make(chan struct{})}
+}
+
+func (f *AsyncFuture) complete(result AsyncResult) {
+	f.result = result
+	close(f.done)
+}
+
+// Done returns a channel that is closed when the operation finished, for use in a select statement.
+func (f *AsyncFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Ready reports whether the operation finished.
+func (f *AsyncFuture) Ready() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Result returns the result if the operation finished, without blocking.
+func (f *AsyncFuture) Result() (AsyncResult, bool) {
+	if !f.Ready() {
+		return AsyncResult{}, false
+	}
+	return f.result, true
+}
+
+// Wait blocks until the operation finished. Another goroutine has to pump the queue meanwhile,
+// see [AsyncQueue.Run].
+func (f *AsyncFuture) Wait() AsyncResult {
+	<-f.done
+	return f.result
+}
+
+// asyncRequest is a started operation. buffer is C memory owned by the request.
+type asyncRequest struct {
+	future *AsyncFuture
+	buffer unsafe.Pointer
+}
+
+// AsyncQueue wraps an [AsyncIOQueue]. Each operation returns an [AsyncFuture] and the data is copied
+// from and to C memory, so callers only deal with Go-owned bytes.
+//
+// The queue is safe for concurrent use. Results are collected either by calling [AsyncQueue.Poll]
+// once per frame or by running [AsyncQueue.Run] in its own goroutine.
+//
+// Example:
+//
+//	queue, _ := sdl.NewAsyncQueue()
+//	ctx, cancel := context.WithCancel(context.Background())
+//	go queue.Run(ctx)
+//	texture := queue.LoadFile("level1.png")
+//	...
+//	select {
+//	case <-texture.Done():
+//		result := texture.Wait()
+//	default:
+//		// still loading, draw the loading screen
+//	}
+//	...
+//	cancel()
+type AsyncQueue struct {
+	queue   *AsyncIOQueue
+	mu      sync.Mutex
+	next    uintptr
+	pending map[uintptr]*asyncRequest
+}
+
+// NewAsyncQueue creates a queue.
+func NewAsyncQueue() (*AsyncQueue, error) {
+	queue := CreateAsyncIOQueue()
+	if queue == nil {
+		return nil, fmt.Errorf("sdl: create async queue: %s", GetError())
+	}
+	return &AsyncQueue{queue: queue, pending: make(map[uintptr]*asyncRequest)}, nil
+}
+
+// Queue returns the underlying queue.
+func (q *AsyncQueue) Queue() *AsyncIOQueue {
+	return q.queue
+}
+
+// Pending returns the number of operations that haven't been completed yet.
+func (q *AsyncQueue) Pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// submit registers a request before start is called, because the result may be collected by another goroutine
+// before start returns.
+func (q *AsyncQueue) submit(taskType AsyncIOTaskType, buffer unsafe.Pointer, start func(userdata uintptr) bool) *AsyncFuture {
+	req := &asyncRequest{future: newAsyncFuture(), buffer: buffer}
+	q.mu.Lock()
+	q.next++
+	userdata := q.next
+	q.pending[userdata] = req
+	q.mu.Unlock()
+
+	if !start(userdata) {
+		err := fmt.Errorf("sdl: start async I/O: %s", GetError())
+		q.mu.Lock()
+		delete(q.pending, userdata)
+		q.mu.Unlock()
+		Free(buffer)
+		req.future.complete(AsyncResult{Type: taskType, Result: AsyncIOFailure, Err: err})
+	}
+	return req.future
+}
+
+func failedAsyncFuture(taskType AsyncIOTaskType, err error) *AsyncFuture {
+	future := newAsyncFuture()
+	future.complete(AsyncResult{Type: taskType, Result: AsyncIOFailure, Err: err})
+	return future
+}
+
+// Read reads size bytes at offset from file.
+func (q *AsyncQueue) Read(file *AsyncIO, offset, size uint64) *AsyncFuture {
+	if size == 0 {
+		future := newAsyncFuture()
+		future.complete(AsyncResult{Type: AsyncIOTaskRead, Offset: offset, Data: []byte{}})
+		return future
+	}
+	buffer := Malloc(size)
+	if buffer == nil {
+		return failedAsyncFuture(AsyncIOTaskRead, fmt.Errorf("sdl: allocate %d bytes: %s", size, GetError()))
+	}
+	return q.submit(AsyncIOTaskRead, buffer, func(userdata uintptr) bool {
+		return sdlReadAsyncIO(file, buffer, offset, size, q.queue, userdata)
+	})
+}
+
+// Write writes data at offset to file. data is copied, so it can be reused right away.
+func (q *AsyncQueue) Write(file *AsyncIO, offset uint64, data []byte) *AsyncFuture {
+	size := uint64(len(data))
+	if size == 0 {
+		future := newAsyncFuture()
+		future.complete(AsyncResult{Type: AsyncIOTaskWrite, Offset: offset})
+		return future
+	}
+	buffer := Malloc(size)
+	if buffer == nil {
+		return failedAsyncFuture(AsyncIOTaskWrite, fmt.Errorf("sdl: allocate %d bytes: %s", size, GetError()))
+	}
+	copy(unsafe.Slice((*byte)(buffer), size), data)
+	return q.submit(AsyncIOTaskWrite, buffer, func(userdata uintptr) bool {
+		return sdlWriteAsyncIO(file, buffer, offset, size, q.queue, userdata)
+	})
+}
+
+// LoadFile reads the whole file at path.
+func (q *AsyncQueue) LoadFile(path string) *AsyncFuture {
+	return q.submit(AsyncIOTaskRead, nil, func(userdata uintptr) bool {
+		return sdlLoadFileAsync(path, q.queue, userdata)
+	})
+}
+
+// CloseFile closes file after the pending operations on it are finished.
+// With flush, the data is synced to disk before the result is reported.
+func (q *AsyncQueue) CloseFile(file *AsyncIO, flush bool) *AsyncFuture {
+	return q.submit(AsyncIOTaskClose, nil, func(userdata uintptr) bool {
+		return sdlCloseAsyncIO(file, flush, q.queue, userdata)
+	})
+}
+
+// complete resolves the future of a finished task. Tasks that weren't started by q are ignored.
+func (q *AsyncQueue) complete(outcome *AsyncIOOutcome) {
+	userdata := outcome.Userdata
+	q.mu.Lock()
+	req := q.pending[userdata]
+	delete(q.pending, userdata)
+	q.mu.Unlock()
+	if req == nil {
+		return
+	}
+
+	result := AsyncResult{
+		Type:             outcome.Type,
+		Result:           outcome.Result,
+		Offset:           outcome.Offset,
+		BytesRequested:   outcome.BytesRequested,
+		BytesTransferred: outcome.BytesTransferred,
+	}
+	if outcome.Type == AsyncIOTaskRead && outcome.Buffer != nil {
+		result.Data = make([]byte, outcome.BytesTransferred)
+		copy(result.Data, unsafe.Slice((*byte)(outcome.Buffer), outcome.BytesTransferred))
+	}
+	if req.buffer != nil {
+		Free(req.buffer)
+	} else if outcome.Type == AsyncIOTaskRead {
+		// the buffer of LoadFileAsync was allocated by SDL
+		Free(outcome.Buffer)
+	}
+	switch outcome.Result {
+	case AsyncIOFailure:
+		result.Err = ErrAsyncIOFailed
+	case AsyncIOCanceled:
+		result.Err = ErrAsyncIOCanceled
+	}
+	req.future.complete(result)
+}
+
+// Poll completes the futures of all finished operations without blocking and returns their number.
+// Call it once per frame if the queue isn't pumped by [AsyncQueue.Run].
+func (q *AsyncQueue) Poll() int {
+	n := 0
+	var outcome AsyncIOOutcome
+	for GetAsyncIOResult(q.queue, &outcome) {
+		q.complete(&outcome)
+		n++
+	}
+	return n
+}
+
+// Run completes futures as the operations finish, until ctx is canceled. It is meant to run in its own goroutine
+// and uses [SignalAsyncIOQueue] to wake up when ctx is canceled. It returns the error of ctx.
+func (q *AsyncQueue) Run(ctx context.Context) error {
+	stopped := make(chan struct{})
+	defer close(stopped)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stopped:
+			return
+		}
+		// a signal only wakes a thread that is already waiting, so repeat it until Run returned
+		for {
+			SignalAsyncIOQueue(q.queue)
+			select {
+			case <-stopped:
+				return
+			case <-time.After(time.Millisecond):
+			}
+		}
+	}()
+
+	var outcome AsyncIOOutcome
+	for ctx.Err() == nil {
+		if WaitAsyncIOResult(q.queue, &outcome, -1) {
+			q.complete(&outcome)
+		}
+	}
+	return ctx.Err()
+}
+
+// Close waits for the pending operations, completes their futures and destroys the queue.
+// [AsyncQueue.Run] must have returned before.
+func (q *AsyncQueue) Close() {
+	var outcome AsyncIOOutcome
+	for q.Pending() > 0 {
+		if WaitAsyncIOResult(q.queue, &outcome, -1) {
+			q.complete(&outcome)
+		}
+	}
+	DestroyAsyncIOQueue(q.queue)
+	q.queue = nil
+}
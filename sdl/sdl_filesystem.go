@@ -1,8 +1,12 @@
 package sdl
 
 import (
+	"strconv"
+	"sync"
+	"time"
 	"unsafe"
 
+	"github.com/ebitengine/purego"
 	"github.com/jupiterrider/purego-sdl3/internal/convert"
 )
 
@@ -23,6 +27,137 @@ const (
 	PathTypeOther
 )
 
+func (t PathType) String() string {
+	switch t {
+	case PathTypeNone:
+		return "none"
+	case PathTypeFile:
+		return "file"
+	case PathTypeDirectory:
+		return "directory"
+	case PathTypeOther:
+		return "other"
+	}
+	return "PathType(" + strconv.Itoa(int(t)) + ")"
+}
+
+type GlobFlags uint32
+
+const GlobCaseInsensitive GlobFlags = 1 << 0
+
+// PathInfo holds information about a path in the filesystem or in a [Storage].
+// Times that the platform doesn't provide are zero.
+type PathInfo struct {
+	Type       PathType
+	Size       uint64
+	CreateTime time.Time
+	ModifyTime time.Time
+	AccessTime time.Time
+}
+
+// pathInfo is the C layout of [PathInfo]. The times are nanoseconds since the Unix epoch.
+type pathInfo struct {
+	typ        PathType
+	size       uint64
+	createTime int64
+	modifyTime int64
+	accessTime int64
+}
+
+func (p *pathInfo) toGo() PathInfo {
+	return PathInfo{
+		Type:       p.typ,
+		Size:       p.size,
+		CreateTime: nsToTime(p.createTime),
+		ModifyTime: nsToTime(p.modifyTime),
+		AccessTime: nsToTime(p.accessTime),
+	}
+}
+
+func (p *pathInfo) fromGo(info PathInfo) {
+	*p = pathInfo{
+		typ:        info.Type,
+		size:       info.Size,
+		createTime: timeToNs(info.CreateTime),
+		modifyTime: timeToNs(info.ModifyTime),
+		accessTime: timeToNs(info.AccessTime),
+	}
+}
+
+func timeToNs(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func nsToTime(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
+// EnumerateDirectoryCallback is called for each entry of a directory. dirname is the directory
+// including a trailing separator and fname is the name of the entry.
+//
+// Return [EnumContinue] to get the next entry, [EnumSuccess] to stop early or [EnumFailure] to stop with an error.
+type EnumerateDirectoryCallback func(dirname, fname string) EnumerationResult
+
+// enumerations maps the userdata of running enumerations to their callbacks.
+var enumerations = struct {
+	sync.Mutex
+	next      uintptr
+	callbacks map[uintptr]EnumerateDirectoryCallback
+}{callbacks: make(map[uintptr]EnumerateDirectoryCallback)}
+
+var enumerateDirectoryCallback struct {
+	once sync.Once
+	fn   uintptr
+}
+
+// enumerate runs an enumeration function of SDL with callback, which is only valid during the call.
+func enumerate(callback EnumerateDirectoryCallback, run func(fn, userdata uintptr) bool) bool {
+	cb := &enumerateDirectoryCallback
+	cb.once.Do(func() {
+		cb.fn = purego.NewCallback(func(userdata uintptr, dirname, fname *byte) uintptr {
+			enumerations.Lock()
+			callback := enumerations.callbacks[userdata]
+			enumerations.Unlock()
+			if callback == nil {
+				return uintptr(EnumFailure)
+			}
+			return uintptr(callback(convert.ToString(dirname), convert.ToString(fname)))
+		})
+	})
+
+	enumerations.Lock()
+	enumerations.next++
+	userdata := enumerations.next
+	enumerations.callbacks[userdata] = callback
+	enumerations.Unlock()
+
+	defer func() {
+		enumerations.Lock()
+		delete(enumerations.callbacks, userdata)
+		enumerations.Unlock()
+	}()
+	return run(cb.fn, userdata)
+}
+
+// globResult converts the result of a glob function and frees it. SDL allocates the array and the strings as one block.
+func globResult(paths **byte, count int32) []string {
+	if paths == nil {
+		return nil
+	}
+	defer Free(unsafe.Pointer(paths))
+	result := make([]string, count)
+	for i, path := range unsafe.Slice(paths, count) {
+		result[i] = convert.ToString(path)
+	}
+	return result
+}
+
 type Folder uint32
 
 const (
@@ -40,17 +175,39 @@ const (
 	FolderCount
 )
 
-// func CopyFile(oldpath string, newpath string) bool {
-//	return sdlCopyFile(oldpath, newpath)
-// }
+// [CopyFile] copies a file, replacing newpath if it exists.
+//
+// [CopyFile]: https://wiki.libsdl.org/SDL3/SDL_CopyFile
+func CopyFile(oldpath string, newpath string) bool {
+	return sdlCopyFile(oldpath, newpath)
+}
 
-// func CreateDirectory(path string) bool {
-//	return sdlCreateDirectory(path)
-// }
+// [CreateDirectory] creates a directory and any missing parent directories.
+//
+// [CreateDirectory]: https://wiki.libsdl.org/SDL3/SDL_CreateDirectory
+func CreateDirectory(path string) bool {
+	return sdlCreateDirectory(path)
+}
 
-// func EnumerateDirectory(path string, callback EnumerateDirectoryCallback, userdata unsafe.Pointer) bool {
-//	return sdlEnumerateDirectory(path, callback, userdata)
-// }
+// [EnumerateDirectory] enumerates a directory through a callback function. The callback is only called
+// during this function and can stop early by returning [EnumSuccess] or [EnumFailure].
+//
+// Example:
+//
+//	sdl.EnumerateDirectory(dir, func(dirname, fname string) sdl.EnumerationResult {
+//		if strings.HasSuffix(fname, ".sav") {
+//			found = dirname + fname
+//			return sdl.EnumSuccess
+//		}
+//		return sdl.EnumContinue
+//	})
+//
+// [EnumerateDirectory]: https://wiki.libsdl.org/SDL3/SDL_EnumerateDirectory
+func EnumerateDirectory(path string, callback EnumerateDirectoryCallback) bool {
+	return enumerate(callback, func(fn, userdata uintptr) bool {
+		return sdlEnumerateDirectory(path, fn, userdata)
+	})
+}
 
 // GetBasePath return the directory where the application was run from.
 func GetBasePath() string {
@@ -63,9 +220,19 @@ func GetCurrentDirectory() string {
 	return convert.ToString(ret)
 }
 
-// func GetPathInfo(path string, info *PathInfo) bool {
-//	return sdlGetPathInfo(path, info)
-// }
+// [GetPathInfo] gets information about a filesystem path. info may be nil to only check whether the path exists.
+//
+// [GetPathInfo]: https://wiki.libsdl.org/SDL3/SDL_GetPathInfo
+func GetPathInfo(path string, info *PathInfo) bool {
+	var cInfo pathInfo
+	if !sdlGetPathInfo(path, &cInfo) {
+		return false
+	}
+	if info != nil {
+		*info = cInfo.toGo()
+	}
+	return true
+}
 
 func GetPrefPath(org string, app string) string {
 	ret := sdlGetPrefPath(convert.ToBytePtrNullable(org), convert.ToBytePtrNullable(app))
@@ -73,18 +240,33 @@ func GetPrefPath(org string, app string) string {
 	return convert.ToString(ret)
 }
 
-// func GetUserFolder(folder Folder) string {
-//	return sdlGetUserFolder(folder)
-// }
+// [GetUserFolder] finds the most suitable user folder for a specific purpose, or returns an empty string if there is none.
+//
+// [GetUserFolder]: https://wiki.libsdl.org/SDL3/SDL_GetUserFolder
+func GetUserFolder(folder Folder) string {
+	return sdlGetUserFolder(folder)
+}
 
-// func GlobDirectory(path string, pattern string, flags GlobFlags, count *int32) **byte {
-//	return sdlGlobDirectory(path, pattern, flags, count)
-// }
+// [GlobDirectory] enumerates a directory tree, filtered by pattern, and returns the matching paths relative to path.
+// An empty pattern matches everything. It returns nil on failure.
+//
+// [GlobDirectory]: https://wiki.libsdl.org/SDL3/SDL_GlobDirectory
+func GlobDirectory(path string, pattern string, flags GlobFlags) []string {
+	var count int32
+	paths := sdlGlobDirectory(path, convert.ToBytePtrNullable(pattern), flags, &count)
+	return globResult(paths, count)
+}
 
-// func RemovePath(path string) bool {
-//	return sdlRemovePath(path)
-// }
+// [RemovePath] removes a file or an empty directory.
+//
+// [RemovePath]: https://wiki.libsdl.org/SDL3/SDL_RemovePath
+func RemovePath(path string) bool {
+	return sdlRemovePath(path)
+}
 
-// func RenamePath(oldpath string, newpath string) bool {
-//	return sdlRenamePath(oldpath, newpath)
-// }
+// [RenamePath] renames a file or directory, replacing newpath if it exists.
+//
+// [RenamePath]: https://wiki.libsdl.org/SDL3/SDL_RenamePath
+func RenamePath(oldpath string, newpath string) bool {
+	return sdlRenamePath(oldpath, newpath)
+}
@@ -3,6 +3,8 @@ package sdl
 import (
 	"unsafe"
 
+	"github.com/ebitengine/purego"
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
 	"github.com/jupiterrider/purego-sdl3/internal/mem"
 )
 
@@ -127,61 +129,108 @@ func (g *GamepadBinding) OutputAxis() struct {
 	})(unsafe.Pointer(&g.output))
 }
 
-// func AddGamepadMapping(mapping string) int32 {
-//	return sdlAddGamepadMapping(mapping)
-// }
+// [AddGamepadMapping] adds support for gamepads that SDL is unaware of or changes the binding of an existing gamepad.
+//
+// Returns 1 if a new mapping is added, 0 if an existing mapping is updated and -1 on failure.
+//
+// [AddGamepadMapping]: https://wiki.libsdl.org/SDL3/SDL_AddGamepadMapping
+func AddGamepadMapping(mapping string) int32 {
+	return sdlAddGamepadMapping(mapping)
+}
 
-// func AddGamepadMappingsFromFile(file string) int32 {
-//	return sdlAddGamepadMappingsFromFile(file)
-// }
+// [AddGamepadMappingsFromFile] loads a set of gamepad mappings from a file.
+//
+// Returns the number of new mappings added or -1 on failure.
+//
+// [AddGamepadMappingsFromFile]: https://wiki.libsdl.org/SDL3/SDL_AddGamepadMappingsFromFile
+func AddGamepadMappingsFromFile(file string) int32 {
+	return sdlAddGamepadMappingsFromFile(file)
+}
 
-// func AddGamepadMappingsFromIO(src *IOStream, closeio bool) int32 {
-//	return sdlAddGamepadMappingsFromIO(src, closeio)
-// }
+// [AddGamepadMappingsFromIO] loads a set of gamepad mappings from an [IOStream].
+//
+// Returns the number of new mappings added or -1 on failure.
+//
+// [AddGamepadMappingsFromIO]: https://wiki.libsdl.org/SDL3/SDL_AddGamepadMappingsFromIO
+func AddGamepadMappingsFromIO(src *IOStream, closeio bool) int32 {
+	return sdlAddGamepadMappingsFromIO(src, closeio)
+}
 
 func CloseGamepad(gamepad *Gamepad) {
 	sdlCloseGamepad(gamepad)
 }
 
-// func GamepadConnected(gamepad *Gamepad) bool {
-//	return sdlGamepadConnected(gamepad)
-// }
+// [GamepadConnected] checks if a gamepad has been opened and is currently connected.
+//
+// [GamepadConnected]: https://wiki.libsdl.org/SDL3/SDL_GamepadConnected
+func GamepadConnected(gamepad *Gamepad) bool {
+	return sdlGamepadConnected(gamepad)
+}
 
-// func GamepadEventsEnabled() bool {
-//	return sdlGamepadEventsEnabled()
-// }
+// [GamepadEventsEnabled] queries the state of gamepad event processing.
+//
+// [GamepadEventsEnabled]: https://wiki.libsdl.org/SDL3/SDL_GamepadEventsEnabled
+func GamepadEventsEnabled() bool {
+	return sdlGamepadEventsEnabled()
+}
 
-// func GamepadHasAxis(gamepad *Gamepad, axis GamepadAxis) bool {
-//	return sdlGamepadHasAxis(gamepad, axis)
-// }
+// [GamepadHasAxis] queries whether a gamepad has a given axis.
+//
+// [GamepadHasAxis]: https://wiki.libsdl.org/SDL3/SDL_GamepadHasAxis
+func GamepadHasAxis(gamepad *Gamepad, axis GamepadAxis) bool {
+	return sdlGamepadHasAxis(gamepad, axis)
+}
 
-// func GamepadHasButton(gamepad *Gamepad, button GamepadButton) bool {
-//	return sdlGamepadHasButton(gamepad, button)
-// }
+// [GamepadHasButton] queries whether a gamepad has a given button.
+//
+// [GamepadHasButton]: https://wiki.libsdl.org/SDL3/SDL_GamepadHasButton
+func GamepadHasButton(gamepad *Gamepad, button GamepadButton) bool {
+	return sdlGamepadHasButton(gamepad, button)
+}
 
-// func GamepadHasSensor(gamepad *Gamepad, type SensorType) bool {
-//	return sdlGamepadHasSensor(gamepad, type)
-// }
+// [GamepadHasSensor] returns whether a gamepad has a particular sensor.
+//
+// [GamepadHasSensor]: https://wiki.libsdl.org/SDL3/SDL_GamepadHasSensor
+func GamepadHasSensor(gamepad *Gamepad, sensorType SensorType) bool {
+	return sdlGamepadHasSensor(gamepad, sensorType)
+}
 
-// func GamepadSensorEnabled(gamepad *Gamepad, type SensorType) bool {
-//	return sdlGamepadSensorEnabled(gamepad, type)
-// }
+// [GamepadSensorEnabled] queries whether sensor data reporting is enabled for a gamepad.
+//
+// [GamepadSensorEnabled]: https://wiki.libsdl.org/SDL3/SDL_GamepadSensorEnabled
+func GamepadSensorEnabled(gamepad *Gamepad, sensorType SensorType) bool {
+	return sdlGamepadSensorEnabled(gamepad, sensorType)
+}
 
-// func GetGamepadAppleSFSymbolsNameForAxis(gamepad *Gamepad, axis GamepadAxis) string {
-//	return sdlGetGamepadAppleSFSymbolsNameForAxis(gamepad, axis)
-// }
+// [GetGamepadAppleSFSymbolsNameForAxis] returns the sfSymbolsName for a given axis on a gamepad on Apple platforms, or "" if the name can't be found.
+//
+// [GetGamepadAppleSFSymbolsNameForAxis]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadAppleSFSymbolsNameForAxis
+func GetGamepadAppleSFSymbolsNameForAxis(gamepad *Gamepad, axis GamepadAxis) string {
+	return sdlGetGamepadAppleSFSymbolsNameForAxis(gamepad, axis)
+}
 
-// func GetGamepadAppleSFSymbolsNameForButton(gamepad *Gamepad, button GamepadButton) string {
-//	return sdlGetGamepadAppleSFSymbolsNameForButton(gamepad, button)
-// }
+// [GetGamepadAppleSFSymbolsNameForButton] returns the sfSymbolsName for a given button on a gamepad on Apple platforms, or "" if the name can't be found.
+//
+// [GetGamepadAppleSFSymbolsNameForButton]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadAppleSFSymbolsNameForButton
+func GetGamepadAppleSFSymbolsNameForButton(gamepad *Gamepad, button GamepadButton) string {
+	return sdlGetGamepadAppleSFSymbolsNameForButton(gamepad, button)
+}
 
-// func GetGamepadAxis(gamepad *Gamepad, axis GamepadAxis) int16 {
-//	return sdlGetGamepadAxis(gamepad, axis)
-// }
+// [GetGamepadAxis] gets the current state of an axis control on a gamepad.
+//
+// Sticks range from -32768 to 32767, triggers from 0 to 32767. Returns 0 on failure.
+//
+// [GetGamepadAxis]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadAxis
+func GetGamepadAxis(gamepad *Gamepad, axis GamepadAxis) int16 {
+	return sdlGetGamepadAxis(gamepad, axis)
+}
 
-// func GetGamepadAxisFromString(str string) GamepadAxis {
-//	return sdlGetGamepadAxisFromString(str)
-// }
+// [GetGamepadAxisFromString] converts a string like "leftx" into a [GamepadAxis], or [GamepadAxisInvalid] if no match was found.
+//
+// [GetGamepadAxisFromString]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadAxisFromString
+func GetGamepadAxisFromString(str string) GamepadAxis {
+	return sdlGetGamepadAxisFromString(str)
+}
 
 // GetGamepadBindings returns the SDL joystick layer bindings for a gamepad or nil on failure.
 //
@@ -196,65 +245,143 @@ func GetGamepadBindings(gamepad *Gamepad) []*GamepadBinding {
 	return mem.DeepCopy(bindings, count)
 }
 
-// func GetGamepadButton(gamepad *Gamepad, button GamepadButton) bool {
-//	return sdlGetGamepadButton(gamepad, button)
-// }
+// [GetGamepadButton] gets the current state of a button on a gamepad.
+//
+// [GetGamepadButton]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadButton
+func GetGamepadButton(gamepad *Gamepad, button GamepadButton) bool {
+	return sdlGetGamepadButton(gamepad, button)
+}
 
-// func GetGamepadButtonFromString(str string) GamepadButton {
-//	return sdlGetGamepadButtonFromString(str)
-// }
+// [GetGamepadButtonFromString] converts a string like "a" into a [GamepadButton], or [GamepadButtonInvalid] if no match was found.
+//
+// [GetGamepadButtonFromString]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadButtonFromString
+func GetGamepadButtonFromString(str string) GamepadButton {
+	return sdlGetGamepadButtonFromString(str)
+}
 
-// func GetGamepadButtonLabel(gamepad *Gamepad, button GamepadButton) GamepadButtonLabel {
-//	return sdlGetGamepadButtonLabel(gamepad, button)
-// }
+// [GetGamepadButtonLabel] gets the label of a button on a gamepad, e.g. [GamepadButtonLabelCross] for [GamepadButtonSouth] on a PlayStation controller.
+//
+// [GetGamepadButtonLabel]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadButtonLabel
+func GetGamepadButtonLabel(gamepad *Gamepad, button GamepadButton) GamepadButtonLabel {
+	return sdlGetGamepadButtonLabel(gamepad, button)
+}
 
-// func GetGamepadButtonLabelForType(type GamepadType, button GamepadButton) GamepadButtonLabel {
-//	return sdlGetGamepadButtonLabelForType(type, button)
-// }
+// [GetGamepadButtonLabelForType] gets the label of a button on a gamepad type.
+//
+// [GetGamepadButtonLabelForType]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadButtonLabelForType
+func GetGamepadButtonLabelForType(gamepadType GamepadType, button GamepadButton) GamepadButtonLabel {
+	return sdlGetGamepadButtonLabelForType(gamepadType, button)
+}
 
-// func GetGamepadConnectionState(gamepad *Gamepad) JoystickConnectionState {
-//	return sdlGetGamepadConnectionState(gamepad)
-// }
+// [GetGamepadConnectionState] gets the connection state of a gamepad.
+//
+// [GetGamepadConnectionState]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadConnectionState
+func GetGamepadConnectionState(gamepad *Gamepad) JoystickConnectionState {
+	return sdlGetGamepadConnectionState(gamepad)
+}
 
-// func GetGamepadFirmwareVersion(gamepad *Gamepad) uint16 {
-//	return sdlGetGamepadFirmwareVersion(gamepad)
-// }
+// [GetGamepadFirmwareVersion] gets the firmware version of an opened gamepad, or 0 if unavailable.
+//
+// [GetGamepadFirmwareVersion]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadFirmwareVersion
+func GetGamepadFirmwareVersion(gamepad *Gamepad) uint16 {
+	return sdlGetGamepadFirmwareVersion(gamepad)
+}
 
 func GetGamepadFromID(instanceId JoystickID) *Gamepad {
 	return sdlGetGamepadFromID(instanceId)
 }
 
-// func GetGamepadFromPlayerIndex(player_index int32) *Gamepad {
-//	return sdlGetGamepadFromPlayerIndex(player_index)
-// }
+// [GetGamepadFromPlayerIndex] gets the gamepad associated with a player index, or nil if there is none.
+//
+// [GetGamepadFromPlayerIndex]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadFromPlayerIndex
+func GetGamepadFromPlayerIndex(playerIndex int32) *Gamepad {
+	return sdlGetGamepadFromPlayerIndex(playerIndex)
+}
 
-// func GetGamepadGUIDForID(instance_id JoystickID) GUID {
-//	return sdlGetGamepadGUIDForID(instance_id)
-// }
+// [GetGamepadGUIDForID] gets the implementation-dependent GUID of a gamepad.
+//
+// This can be called before any gamepads are opened.
+//
+// [GetGamepadGUIDForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadGUIDForID
+func GetGamepadGUIDForID(instanceId JoystickID) GUID {
+	return guidCall(sdlGetGamepadGUIDForID, uintptr(instanceId))
+}
 
-// func GetGamepadID(gamepad *Gamepad) JoystickID {
-//	return sdlGetGamepadID(gamepad)
-// }
+// [GetGamepadID] gets the instance ID of an opened gamepad, or 0 on failure.
+//
+// [GetGamepadID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadID
+func GetGamepadID(gamepad *Gamepad) JoystickID {
+	return sdlGetGamepadID(gamepad)
+}
 
-// func GetGamepadJoystick(gamepad *Gamepad) *Joystick {
-//	return sdlGetGamepadJoystick(gamepad)
-// }
+// [GetGamepadJoystick] gets the underlying joystick from a gamepad.
+//
+// [GetGamepadJoystick]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadJoystick
+func GetGamepadJoystick(gamepad *Gamepad) *Joystick {
+	return sdlGetGamepadJoystick(gamepad)
+}
 
-// func GetGamepadMapping(gamepad *Gamepad) string {
-//	return sdlGetGamepadMapping(gamepad)
-// }
+// [GetGamepadMapping] gets the current mapping of a gamepad or "" if no mapping is available.
+//
+// [GetGamepadMapping]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadMapping
+func GetGamepadMapping(gamepad *Gamepad) string {
+	ret := sdlGetGamepadMapping(gamepad)
+	if ret == nil {
+		return ""
+	}
+	defer Free(unsafe.Pointer(ret))
+	return convert.ToString(ret)
+}
 
-// func GetGamepadMappingForGUID(guid GUID) string {
-//	return sdlGetGamepadMappingForGUID(guid)
-// }
+// [GetGamepadMappingForGUID] gets the gamepad mapping string for a given GUID or "" if no mapping is available.
+//
+// [GetGamepadMappingForGUID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadMappingForGUID
+func GetGamepadMappingForGUID(guid GUID) string {
+	var ret uintptr
+	if guidByReference {
+		ret, _, _ = purego.SyscallN(sdlGetGamepadMappingForGUID, uintptr(unsafe.Pointer(&guid)))
+	} else {
+		words := guidWords(&guid)
+		ret, _, _ = purego.SyscallN(sdlGetGamepadMappingForGUID, words[:guidWordCount]...)
+	}
+	mapping := *(**byte)(unsafe.Pointer(&ret))
+	if mapping == nil {
+		return ""
+	}
+	defer Free(unsafe.Pointer(mapping))
+	return convert.ToString(mapping)
+}
 
-// func GetGamepadMappingForID(instance_id JoystickID) string {
-//	return sdlGetGamepadMappingForID(instance_id)
-// }
+// [GetGamepadMappingForID] gets the mapping of a gamepad or "" if no mapping is available.
+//
+// This can be called before any gamepads are opened.
+//
+// [GetGamepadMappingForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadMappingForID
+func GetGamepadMappingForID(instanceId JoystickID) string {
+	ret := sdlGetGamepadMappingForID(instanceId)
+	if ret == nil {
+		return ""
+	}
+	defer Free(unsafe.Pointer(ret))
+	return convert.ToString(ret)
+}
 
-// func GetGamepadMappings(count *int32) **byte {
-//	return sdlGetGamepadMappings(count)
-// }
+// [GetGamepadMappings] gets the current gamepad mappings or nil on failure.
+//
+// [GetGamepadMappings]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadMappings
+func GetGamepadMappings() []string {
+	var count int32
+	mappings := sdlGetGamepadMappings(&count)
+	if mappings == nil {
+		return nil
+	}
+	defer Free(unsafe.Pointer(mappings))
+	result := make([]string, count)
+	for i, mapping := range unsafe.Slice(mappings, count) {
+		result[i] = convert.ToString(mapping)
+	}
+	return result
+}
 
 func GetGamepadName(gamepad *Gamepad) string {
 	return sdlGetGamepadName(gamepad)
@@ -264,45 +391,77 @@ func GetGamepadNameForID(instanceId JoystickID) string {
 	return sdlGetGamepadNameForID(instanceId)
 }
 
-// func GetGamepadPath(gamepad *Gamepad) string {
-//	return sdlGetGamepadPath(gamepad)
-// }
+// [GetGamepadPath] gets the implementation-dependent path for an opened gamepad, or "" if unavailable.
+//
+// [GetGamepadPath]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPath
+func GetGamepadPath(gamepad *Gamepad) string {
+	return sdlGetGamepadPath(gamepad)
+}
 
-// func GetGamepadPathForID(instance_id JoystickID) string {
-//	return sdlGetGamepadPathForID(instance_id)
-// }
+// [GetGamepadPathForID] gets the implementation-dependent path of a gamepad, or "" if unavailable.
+//
+// This can be called before any gamepads are opened.
+//
+// [GetGamepadPathForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPathForID
+func GetGamepadPathForID(instanceId JoystickID) string {
+	return sdlGetGamepadPathForID(instanceId)
+}
 
-// func GetGamepadPlayerIndex(gamepad *Gamepad) int32 {
-//	return sdlGetGamepadPlayerIndex(gamepad)
-// }
+// [GetGamepadPlayerIndex] gets the player index of an opened gamepad, or -1 if it's not available.
+//
+// [GetGamepadPlayerIndex]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPlayerIndex
+func GetGamepadPlayerIndex(gamepad *Gamepad) int32 {
+	return sdlGetGamepadPlayerIndex(gamepad)
+}
 
-// func GetGamepadPlayerIndexForID(instance_id JoystickID) int32 {
-//	return sdlGetGamepadPlayerIndexForID(instance_id)
-// }
+// [GetGamepadPlayerIndexForID] gets the player index of a gamepad, or -1 if it's not available.
+//
+// [GetGamepadPlayerIndexForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPlayerIndexForID
+func GetGamepadPlayerIndexForID(instanceId JoystickID) int32 {
+	return sdlGetGamepadPlayerIndexForID(instanceId)
+}
 
-// func GetGamepadPowerInfo(gamepad *Gamepad, percent *int32) PowerState {
-//	return sdlGetGamepadPowerInfo(gamepad, percent)
-// }
+// [GetGamepadPowerInfo] gets the battery state of a gamepad. If percent is not nil, it receives the charge in percent, or -1 if unknown.
+//
+// [GetGamepadPowerInfo]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadPowerInfo
+func GetGamepadPowerInfo(gamepad *Gamepad, percent *int32) PowerState {
+	return sdlGetGamepadPowerInfo(gamepad, percent)
+}
 
-// func GetGamepadProduct(gamepad *Gamepad) uint16 {
-//	return sdlGetGamepadProduct(gamepad)
-// }
+// [GetGamepadProduct] gets the USB product ID of an opened gamepad, or 0 if unavailable.
+//
+// [GetGamepadProduct]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProduct
+func GetGamepadProduct(gamepad *Gamepad) uint16 {
+	return sdlGetGamepadProduct(gamepad)
+}
 
-// func GetGamepadProductForID(instance_id JoystickID) uint16 {
-//	return sdlGetGamepadProductForID(instance_id)
-// }
+// [GetGamepadProductForID] gets the USB product ID of a gamepad, or 0 if unavailable.
+//
+// [GetGamepadProductForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProductForID
+func GetGamepadProductForID(instanceId JoystickID) uint16 {
+	return sdlGetGamepadProductForID(instanceId)
+}
 
-// func GetGamepadProductVersion(gamepad *Gamepad) uint16 {
-//	return sdlGetGamepadProductVersion(gamepad)
-// }
+// [GetGamepadProductVersion] gets the product version of an opened gamepad, or 0 if unavailable.
+//
+// [GetGamepadProductVersion]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProductVersion
+func GetGamepadProductVersion(gamepad *Gamepad) uint16 {
+	return sdlGetGamepadProductVersion(gamepad)
+}
 
-// func GetGamepadProductVersionForID(instance_id JoystickID) uint16 {
-//	return sdlGetGamepadProductVersionForID(instance_id)
-// }
+// [GetGamepadProductVersionForID] gets the product version of a gamepad, or 0 if unavailable.
+//
+// [GetGamepadProductVersionForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProductVersionForID
+func GetGamepadProductVersionForID(instanceId JoystickID) uint16 {
+	return sdlGetGamepadProductVersionForID(instanceId)
+}
 
-// func GetGamepadProperties(gamepad *Gamepad) PropertiesID {
-//	return sdlGetGamepadProperties(gamepad)
-// }
+// [GetGamepadProperties] gets the properties associated with an opened gamepad.
+//
+// [GetGamepadProperties]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadProperties
+func GetGamepadProperties(gamepad *Gamepad) PropertiesID {
+	return sdlGetGamepadProperties(gamepad)
+}
 
 // GetGamepads returns a list of currently connected gamepads or nil on failure.
 //
@@ -314,124 +473,216 @@ func GetGamepads() []JoystickID {
 	return mem.Copy(gamepads, count)
 }
 
-// func GetGamepadSensorData(gamepad *Gamepad, type SensorType, data *float32, num_values int32) bool {
-//	return sdlGetGamepadSensorData(gamepad, type, data, num_values)
-// }
+// [GetGamepadSensorData] gets the current state of a gamepad sensor.
+//
+// n is the number of values to read, for example 3 for [SensorAccel] and [SensorGyro].
+// It returns nil on failure.
+//
+// [GetGamepadSensorData]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadSensorData
+func GetGamepadSensorData(gamepad *Gamepad, sensorType SensorType, n int) []float32 {
+	if n <= 0 {
+		return nil
+	}
+	data := make([]float32, n)
+	if !sdlGetGamepadSensorData(gamepad, sensorType, &data[0], int32(n)) {
+		return nil
+	}
+	return data
+}
 
-// func GetGamepadSensorDataRate(gamepad *Gamepad, type SensorType) float32 {
-//	return sdlGetGamepadSensorDataRate(gamepad, type)
-// }
+// [GetGamepadSensorDataRate] gets the data rate (number of events per second) of a gamepad sensor, or 0 if unavailable.
+//
+// [GetGamepadSensorDataRate]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadSensorDataRate
+func GetGamepadSensorDataRate(gamepad *Gamepad, sensorType SensorType) float32 {
+	return sdlGetGamepadSensorDataRate(gamepad, sensorType)
+}
 
 // GetGamepadSerial returns the serial number of an opened gamepad, or "" if unavailable.
 func GetGamepadSerial(gamepad *Gamepad) string {
 	return sdlGetGamepadSerial(gamepad)
 }
 
-// func GetGamepadSteamHandle(gamepad *Gamepad) uint64 {
-//	return sdlGetGamepadSteamHandle(gamepad)
-// }
+// [GetGamepadSteamHandle] gets the Steam Input handle of an opened gamepad, or 0 if unavailable.
+//
+// [GetGamepadSteamHandle]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadSteamHandle
+func GetGamepadSteamHandle(gamepad *Gamepad) uint64 {
+	return sdlGetGamepadSteamHandle(gamepad)
+}
 
-// func GetGamepadStringForAxis(axis GamepadAxis) string {
-//	return sdlGetGamepadStringForAxis(axis)
-// }
+// [GetGamepadStringForAxis] converts a [GamepadAxis] into the string used in mappings, or "" for an invalid axis.
+//
+// [GetGamepadStringForAxis]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadStringForAxis
+func GetGamepadStringForAxis(axis GamepadAxis) string {
+	return sdlGetGamepadStringForAxis(axis)
+}
 
 // GetGamepadStringForButton returns the name for the given button.
 func GetGamepadStringForButton(button GamepadButton) string {
 	return sdlGetGamepadStringForButton(button)
 }
 
+// GetGamepadStringForType returns the name for the given gamepad type.
 func GetGamepadStringForType(gamepadType GamepadType) string {
 	return sdlGetGamepadStringForType(gamepadType)
 }
 
-// func GetGamepadTouchpadFinger(gamepad *Gamepad, touchpad int32, finger int32, down *bool, x *float32, y *float32, pressure *float32) bool {
-//	return sdlGetGamepadTouchpadFinger(gamepad, touchpad, finger, down, x, y, pressure)
-// }
+// [GetGamepadTouchpadFinger] gets the current state of a finger on a touchpad on a gamepad.
+//
+// Any of the output pointers may be nil.
+//
+// [GetGamepadTouchpadFinger]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadTouchpadFinger
+func GetGamepadTouchpadFinger(gamepad *Gamepad, touchpad int32, finger int32, down *bool, x *float32, y *float32, pressure *float32) bool {
+	return sdlGetGamepadTouchpadFinger(gamepad, touchpad, finger, down, x, y, pressure)
+}
 
 func GetGamepadType(gamepad *Gamepad) GamepadType {
 	return sdlGetGamepadType(gamepad)
 }
 
-// func GetGamepadTypeForID(instance_id JoystickID) GamepadType {
-//	return sdlGetGamepadTypeForID(instance_id)
-// }
+// [GetGamepadTypeForID] gets the type of a gamepad.
+//
+// This can be called before any gamepads are opened.
+//
+// [GetGamepadTypeForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadTypeForID
+func GetGamepadTypeForID(instanceId JoystickID) GamepadType {
+	return sdlGetGamepadTypeForID(instanceId)
+}
 
-// func GetGamepadTypeFromString(str string) GamepadType {
-//	return sdlGetGamepadTypeFromString(str)
-// }
+// [GetGamepadTypeFromString] converts a string like "ps5" into a [GamepadType], or [GamepadTypeUnknown] if no match was found.
+//
+// [GetGamepadTypeFromString]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadTypeFromString
+func GetGamepadTypeFromString(str string) GamepadType {
+	return sdlGetGamepadTypeFromString(str)
+}
 
-// func GetGamepadVendor(gamepad *Gamepad) uint16 {
-//	return sdlGetGamepadVendor(gamepad)
-// }
+// [GetGamepadVendor] gets the USB vendor ID of an opened gamepad, or 0 if unavailable.
+//
+// [GetGamepadVendor]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadVendor
+func GetGamepadVendor(gamepad *Gamepad) uint16 {
+	return sdlGetGamepadVendor(gamepad)
+}
 
-// func GetGamepadVendorForID(instance_id JoystickID) uint16 {
-//	return sdlGetGamepadVendorForID(instance_id)
-// }
+// [GetGamepadVendorForID] gets the USB vendor ID of a gamepad, or 0 if unavailable.
+//
+// [GetGamepadVendorForID]: https://wiki.libsdl.org/SDL3/SDL_GetGamepadVendorForID
+func GetGamepadVendorForID(instanceId JoystickID) uint16 {
+	return sdlGetGamepadVendorForID(instanceId)
+}
 
-// func GetNumGamepadTouchpadFingers(gamepad *Gamepad, touchpad int32) int32 {
-//	return sdlGetNumGamepadTouchpadFingers(gamepad, touchpad)
-// }
+// [GetNumGamepadTouchpadFingers] gets the number of supported simultaneous fingers on a touchpad on a gamepad.
+//
+// [GetNumGamepadTouchpadFingers]: https://wiki.libsdl.org/SDL3/SDL_GetNumGamepadTouchpadFingers
+func GetNumGamepadTouchpadFingers(gamepad *Gamepad, touchpad int32) int32 {
+	return sdlGetNumGamepadTouchpadFingers(gamepad, touchpad)
+}
 
-// func GetNumGamepadTouchpads(gamepad *Gamepad) int32 {
-//	return sdlGetNumGamepadTouchpads(gamepad)
-// }
+// [GetNumGamepadTouchpads] gets the number of touchpads on a gamepad.
+//
+// [GetNumGamepadTouchpads]: https://wiki.libsdl.org/SDL3/SDL_GetNumGamepadTouchpads
+func GetNumGamepadTouchpads(gamepad *Gamepad) int32 {
+	return sdlGetNumGamepadTouchpads(gamepad)
+}
 
-// func GetRealGamepadType(gamepad *Gamepad) GamepadType {
-//	return sdlGetRealGamepadType(gamepad)
-// }
+// [GetRealGamepadType] gets the type of an opened gamepad, ignoring any mapping override.
+//
+// [GetRealGamepadType]: https://wiki.libsdl.org/SDL3/SDL_GetRealGamepadType
+func GetRealGamepadType(gamepad *Gamepad) GamepadType {
+	return sdlGetRealGamepadType(gamepad)
+}
 
-// func GetRealGamepadTypeForID(instance_id JoystickID) GamepadType {
-//	return sdlGetRealGamepadTypeForID(instance_id)
-// }
+// [GetRealGamepadTypeForID] gets the type of a gamepad, ignoring any mapping override.
+//
+// [GetRealGamepadTypeForID]: https://wiki.libsdl.org/SDL3/SDL_GetRealGamepadTypeForID
+func GetRealGamepadTypeForID(instanceId JoystickID) GamepadType {
+	return sdlGetRealGamepadTypeForID(instanceId)
+}
 
-// func HasGamepad() bool {
-//	return sdlHasGamepad()
-// }
+// [HasGamepad] returns whether a gamepad is currently connected.
+//
+// [HasGamepad]: https://wiki.libsdl.org/SDL3/SDL_HasGamepad
+func HasGamepad() bool {
+	return sdlHasGamepad()
+}
 
-// func IsGamepad(instance_id JoystickID) bool {
-//	return sdlIsGamepad(instance_id)
-// }
+// [IsGamepad] checks if the given joystick is supported by the gamepad interface.
+//
+// [IsGamepad]: https://wiki.libsdl.org/SDL3/SDL_IsGamepad
+func IsGamepad(instanceId JoystickID) bool {
+	return sdlIsGamepad(instanceId)
+}
 
 func OpenGamepad(instanceId JoystickID) *Gamepad {
 	return sdlOpenGamepad(instanceId)
 }
 
-// func ReloadGamepadMappings() bool {
-//	return sdlReloadGamepadMappings()
-// }
+// [ReloadGamepadMappings] reinitializes the SDL mapping database to its initial state.
+//
+// [ReloadGamepadMappings]: https://wiki.libsdl.org/SDL3/SDL_ReloadGamepadMappings
+func ReloadGamepadMappings() bool {
+	return sdlReloadGamepadMappings()
+}
 
-// func RumbleGamepad(gamepad *Gamepad, low_frequency_rumble uint16, high_frequency_rumble uint16, duration_ms uint32) bool {
-//	return sdlRumbleGamepad(gamepad, low_frequency_rumble, high_frequency_rumble, duration_ms)
-// }
+// [RumbleGamepad] starts a rumble effect on a gamepad. Each call cancels any previous rumble effect, and calling it with 0 intensity stops any rumbling.
+//
+// [RumbleGamepad]: https://wiki.libsdl.org/SDL3/SDL_RumbleGamepad
+func RumbleGamepad(gamepad *Gamepad, lowFrequencyRumble uint16, highFrequencyRumble uint16, durationMs uint32) bool {
+	return sdlRumbleGamepad(gamepad, lowFrequencyRumble, highFrequencyRumble, durationMs)
+}
 
-// func RumbleGamepadTriggers(gamepad *Gamepad, left_rumble uint16, right_rumble uint16, duration_ms uint32) bool {
-//	return sdlRumbleGamepadTriggers(gamepad, left_rumble, right_rumble, duration_ms)
-// }
+// [RumbleGamepadTriggers] starts a rumble effect in the gamepad's triggers. Each call cancels any previous trigger rumble effect.
+//
+// [RumbleGamepadTriggers]: https://wiki.libsdl.org/SDL3/SDL_RumbleGamepadTriggers
+func RumbleGamepadTriggers(gamepad *Gamepad, leftRumble uint16, rightRumble uint16, durationMs uint32) bool {
+	return sdlRumbleGamepadTriggers(gamepad, leftRumble, rightRumble, durationMs)
+}
 
-// func SendGamepadEffect(gamepad *Gamepad, data unsafe.Pointer, size int32) bool {
-//	return sdlSendGamepadEffect(gamepad, data, size)
-// }
+// [SendGamepadEffect] sends a gamepad specific effect packet.
+//
+// [SendGamepadEffect]: https://wiki.libsdl.org/SDL3/SDL_SendGamepadEffect
+func SendGamepadEffect(gamepad *Gamepad, data unsafe.Pointer, size int32) bool {
+	return sdlSendGamepadEffect(gamepad, data, size)
+}
 
-// func SetGamepadEventsEnabled(enabled bool)  {
-//	sdlSetGamepadEventsEnabled(enabled)
-// }
+// [SetGamepadEventsEnabled] sets the state of gamepad event processing.
+//
+// [SetGamepadEventsEnabled]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadEventsEnabled
+func SetGamepadEventsEnabled(enabled bool) {
+	sdlSetGamepadEventsEnabled(enabled)
+}
 
-// func SetGamepadLED(gamepad *Gamepad, red uint8, green uint8, blue uint8) bool {
-//	return sdlSetGamepadLED(gamepad, red, green, blue)
-// }
+// [SetGamepadLED] updates a gamepad's LED color.
+//
+// [SetGamepadLED]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadLED
+func SetGamepadLED(gamepad *Gamepad, red uint8, green uint8, blue uint8) bool {
+	return sdlSetGamepadLED(gamepad, red, green, blue)
+}
 
-// func SetGamepadMapping(instance_id JoystickID, mapping string) bool {
-//	return sdlSetGamepadMapping(instance_id, mapping)
-// }
+// [SetGamepadMapping] sets the current mapping of a joystick or gamepad.
+//
+// An empty mapping removes the current mapping.
+//
+// [SetGamepadMapping]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadMapping
+func SetGamepadMapping(instanceId JoystickID, mapping string) bool {
+	return sdlSetGamepadMapping(instanceId, convert.ToBytePtrNullable(mapping))
+}
 
-// func SetGamepadPlayerIndex(gamepad *Gamepad, player_index int32) bool {
-//	return sdlSetGamepadPlayerIndex(gamepad, player_index)
-// }
+// [SetGamepadPlayerIndex] sets the player index of an opened gamepad. Use -1 to clear the player index and turn off player LEDs.
+//
+// [SetGamepadPlayerIndex]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadPlayerIndex
+func SetGamepadPlayerIndex(gamepad *Gamepad, playerIndex int32) bool {
+	return sdlSetGamepadPlayerIndex(gamepad, playerIndex)
+}
 
-// func SetGamepadSensorEnabled(gamepad *Gamepad, type SensorType, enabled bool) bool {
-//	return sdlSetGamepadSensorEnabled(gamepad, type, enabled)
-// }
+// [SetGamepadSensorEnabled] sets whether data reporting for a gamepad sensor is enabled.
+//
+// [SetGamepadSensorEnabled]: https://wiki.libsdl.org/SDL3/SDL_SetGamepadSensorEnabled
+func SetGamepadSensorEnabled(gamepad *Gamepad, sensorType SensorType, enabled bool) bool {
+	return sdlSetGamepadSensorEnabled(gamepad, sensorType, enabled)
+}
 
-// func UpdateGamepads()  {
-//	sdlUpdateGamepads()
-// }
+// [UpdateGamepads] manually pumps gamepad updates if not using the event loop.
+//
+// [UpdateGamepads]: https://wiki.libsdl.org/SDL3/SDL_UpdateGamepads
+func UpdateGamepads() {
+	sdlUpdateGamepads()
+}
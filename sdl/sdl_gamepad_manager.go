@@ -0,0 +1,324 @@
+package sdl
+
+import "sort"
+
+// ConnectedGamepad describes a controller opened by a [GamepadManager].
+type ConnectedGamepad struct {
+	ID JoystickID
+	// Player is the player slot of the controller, or -1 if all slots are taken.
+	Player int32
+	// Gamepad is nil for joysticks that aren't supported by the gamepad interface.
+	Gamepad *Gamepad
+	// Joystick is the underlying joystick, which is also set for gamepads.
+	Joystick *Joystick
+	Name     string
+	Type     GamepadType
+	GUID     GUID
+	Serial   string
+}
+
+// IsGamepad reports whether the controller is handled by the gamepad interface.
+func (c *ConnectedGamepad) IsGamepad() bool {
+	return c.Gamepad != nil
+}
+
+// gamepadSlot is a player slot. The key of the last controller is kept after it disconnects, so that it can reclaim the slot.
+type gamepadSlot struct {
+	key string
+	id  JoystickID
+}
+
+// GamepadManager opens and closes controllers as they are plugged in and out and assigns them to player slots.
+//
+// A controller that reconnects gets its previous slot back, as long as no other controller took it in the meantime.
+// Controllers are recognized by their [GUID] and serial number. The slot is also passed on to SDL
+// with [SetGamepadPlayerIndex], which lights up the player LEDs on many controllers.
+//
+// Feed every event to [GamepadManager.HandleEvent]. SDL sends an added event for each controller that
+// is already connected when the gamepad subsystem is initialized, so no controller is missed.
+//
+// Example:
+//
+//	pads := sdl.NewGamepadManager(4)
+//	defer pads.Close()
+//	for sdl.PollEvent(&event) {
+//		pads.HandleEvent(&event)
+//	}
+//	for _, pad := range pads.Gamepads() {
+//		fmt.Println(pad.Player, pad.Name)
+//	}
+//
+// The zero value is a manager with unlimited player slots.
+type GamepadManager struct {
+	// MaxPlayers limits the number of player slots. 0 means unlimited.
+	MaxPlayers int
+	// IncludeJoysticks also opens joysticks that aren't supported by the gamepad interface.
+	// It must be set before the first event is handled.
+	IncludeJoysticks bool
+	// OnConnect is called after a controller was opened and got its slot.
+	OnConnect func(pad ConnectedGamepad)
+	// OnDisconnect is called before a removed controller is closed. It is also called when a joystick
+	// is reopened as gamepad after a mapping was added for it, followed by OnConnect for the gamepad.
+	OnDisconnect func(pad ConnectedGamepad)
+
+	pads  map[JoystickID]*ConnectedGamepad
+	slots []gamepadSlot
+}
+
+// NewGamepadManager creates a manager with the given number of player slots, 0 means unlimited.
+func NewGamepadManager(maxPlayers int) *GamepadManager {
+	return &GamepadManager{
+		MaxPlayers: maxPlayers,
+		pads:       make(map[JoystickID]*ConnectedGamepad),
+	}
+}
+
+// HandleEvent opens and closes controllers on device events and reports whether the event was handled.
+func (m *GamepadManager) HandleEvent(event *Event) bool {
+	switch event.Type() {
+	case EventGamepadAdded:
+		m.add(event.GDevice().Which, true)
+	case EventGamepadRemoved:
+		m.remove(event.GDevice().Which)
+	case EventJoystickAdded:
+		which := event.JDevice().Which
+		if !m.IncludeJoysticks || IsGamepad(which) {
+			return false
+		}
+		m.add(which, false)
+	case EventJoystickRemoved:
+		which := event.JDevice().Which
+		if pad, ok := m.pads[which]; !ok || pad.IsGamepad() {
+			return false
+		}
+		m.remove(which)
+	default:
+		return false
+	}
+	return true
+}
+
+func (m *GamepadManager) add(which JoystickID, gamepad bool) {
+	if m.pads == nil {
+		m.pads = make(map[JoystickID]*ConnectedGamepad)
+	}
+	if pad, ok := m.pads[which]; ok {
+		if pad.IsGamepad() || !gamepad {
+			return
+		}
+		// a mapping for an opened joystick was added, reopen it as gamepad in the same slot
+		if m.OnDisconnect != nil {
+			m.OnDisconnect(*pad)
+		}
+		CloseJoystick(pad.Joystick)
+		delete(m.pads, which)
+		if pad.Player >= 0 {
+			m.slots[pad.Player].id = 0
+		}
+	}
+
+	pad := &ConnectedGamepad{ID: which, Player: -1}
+	if gamepad {
+		pad.Gamepad = OpenGamepad(which)
+		if pad.Gamepad == nil {
+			return
+		}
+		pad.Joystick = GetGamepadJoystick(pad.Gamepad)
+		pad.Name = GetGamepadName(pad.Gamepad)
+		pad.Type = GetGamepadType(pad.Gamepad)
+		pad.Serial = GetGamepadSerial(pad.Gamepad)
+	} else {
+		pad.Joystick = OpenJoystick(which)
+		if pad.Joystick == nil {
+			return
+		}
+		pad.Name = GetJoystickName(pad.Joystick)
+		pad.Serial = GetJoystickSerial(pad.Joystick)
+	}
+	pad.GUID = GetJoystickGUID(pad.Joystick)
+	m.pads[which] = pad
+
+	m.assign(pad)
+	if m.OnConnect != nil {
+		m.OnConnect(*pad)
+	}
+}
+
+func (m *GamepadManager) remove(which JoystickID) {
+	pad, ok := m.pads[which]
+	if !ok {
+		return
+	}
+	if m.OnDisconnect != nil {
+		m.OnDisconnect(*pad)
+	}
+	delete(m.pads, which)
+	if pad.Gamepad != nil {
+		CloseGamepad(pad.Gamepad)
+	} else {
+		CloseJoystick(pad.Joystick)
+	}
+	if pad.Player >= 0 {
+		m.slots[pad.Player].id = 0
+		m.promote()
+	}
+}
+
+// gamepadKey identifies a controller across reconnects.
+func gamepadKey(pad *ConnectedGamepad) string {
+	if pad.Serial != "" {
+		return pad.GUID.String() + "/" + pad.Serial
+	}
+	return pad.GUID.String()
+}
+
+// assign gives the controller a free slot, preferring the one it had before, then slots that are not reserved
+// by another controller.
+func (m *GamepadManager) assign(pad *ConnectedGamepad) {
+	key := gamepadKey(pad)
+	slot := -1
+	for i, s := range m.slots {
+		if s.id == 0 && s.key == key {
+			slot = i
+			break
+		}
+	}
+	if slot < 0 {
+		for i, s := range m.slots {
+			if s.id == 0 && s.key == "" {
+				slot = i
+				break
+			}
+		}
+	}
+	if slot < 0 && (m.MaxPlayers <= 0 || len(m.slots) < m.MaxPlayers) {
+		m.slots = append(m.slots, gamepadSlot{})
+		slot = len(m.slots) - 1
+	}
+	if slot < 0 {
+		// all slots exist, take over the one of a controller that is away
+		for i, s := range m.slots {
+			if s.id == 0 {
+				slot = i
+				break
+			}
+		}
+	}
+	if slot < 0 {
+		m.setPlayer(pad, -1)
+		return
+	}
+	m.slots[slot] = gamepadSlot{key: key, id: pad.ID}
+	m.setPlayer(pad, int32(slot))
+}
+
+// promote moves controllers without a slot into free slots.
+func (m *GamepadManager) promote() {
+	for _, pad := range m.sorted() {
+		if pad.Player < 0 {
+			m.assign(pad)
+			if pad.Player < 0 {
+				return
+			}
+		}
+	}
+}
+
+func (m *GamepadManager) setPlayer(pad *ConnectedGamepad, player int32) {
+	pad.Player = player
+	if pad.Gamepad != nil {
+		SetGamepadPlayerIndex(pad.Gamepad, player)
+	} else {
+		SetJoystickPlayerIndex(pad.Joystick, player)
+	}
+}
+
+// sorted returns the controllers ordered by player slot, controllers without a slot last in the order they connected.
+func (m *GamepadManager) sorted() []*ConnectedGamepad {
+	pads := make([]*ConnectedGamepad, 0, len(m.pads))
+	for _, pad := range m.pads {
+		pads = append(pads, pad)
+	}
+	sort.Slice(pads, func(i, j int) bool {
+		a, b := pads[i], pads[j]
+		if (a.Player < 0) != (b.Player < 0) {
+			return b.Player < 0
+		}
+		if a.Player != b.Player {
+			return a.Player < b.Player
+		}
+		return a.ID < b.ID
+	})
+	return pads
+}
+
+// Gamepads returns a snapshot of the connected controllers ordered by player slot.
+func (m *GamepadManager) Gamepads() []ConnectedGamepad {
+	pads := m.sorted()
+	snapshot := make([]ConnectedGamepad, len(pads))
+	for i, pad := range pads {
+		snapshot[i] = *pad
+	}
+	return snapshot
+}
+
+// Player returns the controller in the given player slot.
+func (m *GamepadManager) Player(player int32) (ConnectedGamepad, bool) {
+	if player < 0 || int(player) >= len(m.slots) || m.slots[player].id == 0 {
+		return ConnectedGamepad{}, false
+	}
+	return *m.pads[m.slots[player].id], true
+}
+
+// Get returns the controller with the given instance ID.
+func (m *GamepadManager) Get(which JoystickID) (ConnectedGamepad, bool) {
+	pad, ok := m.pads[which]
+	if !ok {
+		return ConnectedGamepad{}, false
+	}
+	return *pad, true
+}
+
+// Count returns the number of connected controllers.
+func (m *GamepadManager) Count() int {
+	return len(m.pads)
+}
+
+// Swap exchanges the controllers in two player slots. Either slot may be empty.
+func (m *GamepadManager) Swap(a, b int32) {
+	if a < 0 || b < 0 || a == b {
+		return
+	}
+	for int(a) >= len(m.slots) || int(b) >= len(m.slots) {
+		if m.MaxPlayers > 0 && len(m.slots) >= m.MaxPlayers {
+			return
+		}
+		m.slots = append(m.slots, gamepadSlot{})
+	}
+	m.slots[a], m.slots[b] = m.slots[b], m.slots[a]
+	for _, slot := range []int32{a, b} {
+		if id := m.slots[slot].id; id != 0 {
+			m.setPlayer(m.pads[id], slot)
+		}
+	}
+}
+
+// Forget clears the reservation of an empty player slot, so that any controller can take it.
+func (m *GamepadManager) Forget(player int32) {
+	if player >= 0 && int(player) < len(m.slots) && m.slots[player].id == 0 {
+		m.slots[player].key = ""
+	}
+}
+
+// Close closes all controllers and clears the player slots.
+func (m *GamepadManager) Close() {
+	for _, pad := range m.pads {
+		if pad.Gamepad != nil {
+			CloseGamepad(pad.Gamepad)
+		} else {
+			CloseJoystick(pad.Joystick)
+		}
+	}
+	m.pads = make(map[JoystickID]*ConnectedGamepad)
+	m.slots = nil
+}
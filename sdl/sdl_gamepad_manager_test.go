@@ -0,0 +1,66 @@
+package sdl
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func deviceEvent(eventType EventType, which JoystickID) *Event {
+	var event Event
+	*(*JoyDeviceEvent)(unsafe.Pointer(&event)) = JoyDeviceEvent{CommonEvent: CommonEvent{Type: eventType}, Which: which}
+	return &event
+}
+
+func TestGamepadManagerReopen(t *testing.T) {
+	if major, _, _ := GetVersion(); major == 0 {
+		t.Skip("SDL isn't available")
+	}
+	if !InitSubSystem(InitGamepad) {
+		t.Skipf("can't initialize gamepads: %s", GetError())
+	}
+	defer QuitSubSystem(InitGamepad)
+
+	// a virtual joystick of unknown type has no gamepad mapping
+	which := AttachVirtualJoystick(&VirtualJoystickDesc{Type: JoystickTypeUnknown, NAxes: 2, NButtons: 2, Name: "purego-sdl3 test"})
+	if which == 0 {
+		t.Skipf("can't attach a virtual joystick: %s", GetError())
+	}
+	defer DetachVirtualJoystick(which)
+	if IsGamepad(which) {
+		t.Skip("the virtual joystick already is a gamepad")
+	}
+
+	var events []string
+	m := &GamepadManager{MaxPlayers: 4, IncludeJoysticks: true}
+	defer m.Close()
+	m.OnConnect = func(pad ConnectedGamepad) {
+		events = append(events, "connect")
+		if pad.Player != 0 {
+			t.Errorf("connected in slot %d, want 0", pad.Player)
+		}
+	}
+	m.OnDisconnect = func(pad ConnectedGamepad) { events = append(events, "disconnect") }
+
+	if !m.HandleEvent(deviceEvent(EventJoystickAdded, which)) {
+		t.Fatal("the joystick added event wasn't handled")
+	}
+	if pad, ok := m.Player(0); !ok || pad.ID != which || pad.IsGamepad() {
+		t.Fatalf("slot 0 = %+v, %v", pad, ok)
+	}
+
+	if AddGamepadMapping(GetJoystickGUIDForID(which).String()+",purego-sdl3 test,a:b0,b:b1,leftx:a0,lefty:a1,") < 0 {
+		t.Skipf("can't add a mapping: %s", GetError())
+	}
+	m.HandleEvent(deviceEvent(EventGamepadAdded, which))
+	if pad, ok := m.Player(0); !ok || pad.ID != which || !pad.IsGamepad() {
+		t.Errorf("slot 0 after adding a mapping = %+v, %v", pad, ok)
+	}
+	if len(events) != 3 || events[0] != "connect" || events[1] != "disconnect" || events[2] != "connect" {
+		t.Errorf("callbacks = %v, want connect, disconnect, connect", events)
+	}
+
+	m.HandleEvent(deviceEvent(EventGamepadRemoved, which))
+	if m.Count() != 0 || len(events) != 4 {
+		t.Errorf("after removal: %d controllers, callbacks %v", m.Count(), events)
+	}
+}
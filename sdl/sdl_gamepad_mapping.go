@@ -0,0 +1,408 @@
+package sdl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// gamepadButtonNames are the names used for buttons in mapping strings, indexed by [GamepadButton].
+var gamepadButtonNames = [GamepadButtonCount]string{
+	"a", "b", "x", "y", "back", "guide", "start", "leftstick", "rightstick", "leftshoulder", "rightshoulder",
+	"dpup", "dpdown", "dpleft", "dpright", "misc1", "paddle1", "paddle2", "paddle3", "paddle4", "touchpad",
+	"misc2", "misc3", "misc4", "misc5", "misc6",
+}
+
+// gamepadAxisNames are the names used for axes in mapping strings, indexed by [GamepadAxis].
+var gamepadAxisNames = [GamepadAxisCount]string{
+	"leftx", "lefty", "rightx", "righty", "lefttrigger", "righttrigger",
+}
+
+// GamepadMappingSource is the joystick input of a mapping element, like "b0", "h0.4", "a2", "+a1" or "a3~".
+type GamepadMappingSource struct {
+	// Type is either [GamepadBindTypeButton], [GamepadBindTypeAxis] or [GamepadBindTypeHat].
+	Type    GamepadBindingType
+	Index   int32
+	HatMask int32
+	// Half restricts an axis to its positive (1) or negative (-1) half.
+	Half int8
+	// Invert flips the direction of an axis.
+	Invert bool
+}
+
+// ParseGamepadMappingSource parses the joystick side of a mapping element.
+func ParseGamepadMappingSource(s string) (GamepadMappingSource, error) {
+	var source GamepadMappingSource
+	value := s
+	if strings.HasPrefix(value, "+") {
+		source.Half = 1
+		value = value[1:]
+	} else if strings.HasPrefix(value, "-") {
+		source.Half = -1
+		value = value[1:]
+	}
+	if strings.HasSuffix(value, "~") {
+		source.Invert = true
+		value = value[:len(value)-1]
+	}
+	if len(value) < 2 {
+		return source, fmt.Errorf("sdl: invalid mapping source %q", s)
+	}
+
+	switch value[0] {
+	case 'b':
+		source.Type = GamepadBindTypeButton
+	case 'a':
+		source.Type = GamepadBindTypeAxis
+	case 'h':
+		source.Type = GamepadBindTypeHat
+		hat, mask, ok := strings.Cut(value[1:], ".")
+		if !ok {
+			return source, fmt.Errorf("sdl: invalid hat in mapping source %q", s)
+		}
+		n, err := strconv.ParseInt(mask, 10, 32)
+		if err != nil || n <= 0 {
+			return source, fmt.Errorf("sdl: invalid hat mask in mapping source %q", s)
+		}
+		source.HatMask = int32(n)
+		value = "h" + hat
+	default:
+		return source, fmt.Errorf("sdl: invalid mapping source %q", s)
+	}
+	if source.Type != GamepadBindTypeAxis && (source.Half != 0 || source.Invert) {
+		return source, fmt.Errorf("sdl: only axes can be split or inverted in mapping source %q", s)
+	}
+
+	n, err := strconv.ParseInt(value[1:], 10, 32)
+	if err != nil || n < 0 {
+		return source, fmt.Errorf("sdl: invalid index in mapping source %q", s)
+	}
+	source.Index = int32(n)
+	return source, nil
+}
+
+func (s GamepadMappingSource) String() string {
+	var sb strings.Builder
+	if s.Half > 0 {
+		sb.WriteByte('+')
+	} else if s.Half < 0 {
+		sb.WriteByte('-')
+	}
+	switch s.Type {
+	case GamepadBindTypeButton:
+		sb.WriteByte('b')
+	case GamepadBindTypeAxis:
+		sb.WriteByte('a')
+	case GamepadBindTypeHat:
+		sb.WriteByte('h')
+	}
+	sb.WriteString(strconv.Itoa(int(s.Index)))
+	if s.Type == GamepadBindTypeHat {
+		sb.WriteByte('.')
+		sb.WriteString(strconv.Itoa(int(s.HatMask)))
+	}
+	if s.Invert {
+		sb.WriteByte('~')
+	}
+	return sb.String()
+}
+
+// GamepadMappingBinding binds a gamepad button or axis to a joystick input.
+type GamepadMappingBinding struct {
+	// Target is the name of a gamepad element like "a", "dpup" or "leftx".
+	Target string
+	// TargetHalf maps the source onto the positive (1) or negative (-1) half of an axis, like "+leftx".
+	TargetHalf int8
+	Source     GamepadMappingSource
+}
+
+// Key returns the field key of the binding, e.g. "+leftx".
+func (b GamepadMappingBinding) Key() string {
+	switch {
+	case b.TargetHalf > 0:
+		return "+" + b.Target
+	case b.TargetHalf < 0:
+		return "-" + b.Target
+	}
+	return b.Target
+}
+
+// IsButton reports whether the target is a gamepad button.
+func (b GamepadMappingBinding) IsButton() bool {
+	_, ok := gamepadButtonFromName(b.Target)
+	return ok
+}
+
+// Button returns the gamepad button of the target or [GamepadButtonInvalid] if the target is an axis.
+func (b GamepadMappingBinding) Button() GamepadButton {
+	button, _ := gamepadButtonFromName(b.Target)
+	return button
+}
+
+// Axis returns the gamepad axis of the target or [GamepadAxisInvalid] if the target is a button.
+func (b GamepadMappingBinding) Axis() GamepadAxis {
+	axis, _ := gamepadAxisFromName(b.Target)
+	return axis
+}
+
+func gamepadButtonFromName(name string) (GamepadButton, bool) {
+	for i, v := range gamepadButtonNames {
+		if v == name {
+			return GamepadButton(i), true
+		}
+	}
+	return GamepadButtonInvalid, false
+}
+
+func gamepadAxisFromName(name string) (GamepadAxis, bool) {
+	for i, v := range gamepadAxisNames {
+		if v == name {
+			return GamepadAxis(i), true
+		}
+	}
+	return GamepadAxisInvalid, false
+}
+
+// parseBindingKey splits a field key like "+leftx" into its target and half.
+// ok is false if the key does not name a gamepad element.
+func parseBindingKey(key string) (target string, half int8, ok bool) {
+	target = key
+	if strings.HasPrefix(target, "+") {
+		half, target = 1, target[1:]
+	} else if strings.HasPrefix(target, "-") {
+		half, target = -1, target[1:]
+	}
+	if _, ok := gamepadAxisFromName(target); ok {
+		return target, half, true
+	}
+	if _, ok := gamepadButtonFromName(target); ok && half == 0 {
+		return target, 0, true
+	}
+	return "", 0, false
+}
+
+// GamepadMappingField is a single "key:value" pair of a mapping string.
+type GamepadMappingField struct {
+	Key   string
+	Value string
+}
+
+// GamepadMapping is a parsed SDL gamepad mapping string like
+//
+//	03000000de280000ff11000001000000,Steam Virtual Gamepad,a:b0,b:b1,leftx:a0,+lefty:+a1,platform:Windows,
+//
+// The fields keep their original order, so that parsing and formatting a mapping round-trips.
+type GamepadMapping struct {
+	// GUID is the joystick GUID as 32 hexadecimal characters, or "xinput" for the XInput fallback mapping.
+	GUID string
+	Name string
+	// Fields holds the bindings and other fields like "platform", "crc" or "hint" in their original order.
+	Fields []GamepadMappingField
+}
+
+// ParseGamepadMapping parses a mapping string and validates its bindings.
+func ParseGamepadMapping(s string) (GamepadMapping, error) {
+	s = strings.TrimSpace(s)
+	parts := strings.Split(s, ",")
+	if len(parts) < 2 {
+		return GamepadMapping{}, fmt.Errorf("sdl: mapping %q needs at least a GUID and a name", s)
+	}
+
+	mapping := GamepadMapping{GUID: parts[0], Name: parts[1]}
+	if err := validateMappingGUID(mapping.GUID); err != nil {
+		return GamepadMapping{}, err
+	}
+	for _, part := range parts[2:] {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, ":")
+		if !ok || key == "" {
+			return GamepadMapping{}, fmt.Errorf("sdl: invalid field %q in mapping for %q", part, mapping.Name)
+		}
+		if _, _, isBinding := parseBindingKey(key); isBinding && value != "" {
+			if _, err := ParseGamepadMappingSource(value); err != nil {
+				return GamepadMapping{}, fmt.Errorf("sdl: field %q in mapping for %q: %w", key, mapping.Name, err)
+			}
+		}
+		mapping.Fields = append(mapping.Fields, GamepadMappingField{Key: key, Value: value})
+	}
+	return mapping, nil
+}
+
+func validateMappingGUID(guid string) error {
+	if guid == "xinput" {
+		return nil
+	}
+	if len(guid) != 32 {
+		return fmt.Errorf("sdl: mapping GUID %q must have 32 hexadecimal characters", guid)
+	}
+	for _, c := range guid {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return fmt.Errorf("sdl: mapping GUID %q contains invalid character %q", guid, c)
+		}
+	}
+	return nil
+}
+
+// ParseGamepadMappings parses a mapping database like gamecontrollerdb.txt.
+// Empty lines and lines starting with "#" are skipped. Errors report the line number.
+func ParseGamepadMappings(r io.Reader) ([]GamepadMapping, error) {
+	var mappings []GamepadMapping
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		mapping, err := ParseGamepadMapping(text)
+		if err != nil {
+			return mappings, fmt.Errorf("line %d: %w", line, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings, scanner.Err()
+}
+
+// String formats the mapping in the format understood by [AddGamepadMapping], including the trailing comma.
+func (m GamepadMapping) String() string {
+	var sb strings.Builder
+	sb.WriteString(m.GUID)
+	sb.WriteByte(',')
+	sb.WriteString(m.Name)
+	sb.WriteByte(',')
+	for _, field := range m.Fields {
+		sb.WriteString(field.Key)
+		sb.WriteByte(':')
+		sb.WriteString(field.Value)
+		sb.WriteByte(',')
+	}
+	return sb.String()
+}
+
+func (m GamepadMapping) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *GamepadMapping) UnmarshalText(text []byte) error {
+	mapping, err := ParseGamepadMapping(string(text))
+	if err != nil {
+		return err
+	}
+	*m = mapping
+	return nil
+}
+
+// Get returns the value of a field.
+func (m *GamepadMapping) Get(key string) (string, bool) {
+	for _, field := range m.Fields {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// Set changes the value of a field or appends it. New bindings are inserted in front of the "platform" field.
+func (m *GamepadMapping) Set(key, value string) {
+	for i, field := range m.Fields {
+		if field.Key == key {
+			m.Fields[i].Value = value
+			return
+		}
+	}
+	field := GamepadMappingField{Key: key, Value: value}
+	if _, _, isBinding := parseBindingKey(key); isBinding {
+		for i, f := range m.Fields {
+			if f.Key == "platform" {
+				m.Fields = append(m.Fields[:i], append([]GamepadMappingField{field}, m.Fields[i:]...)...)
+				return
+			}
+		}
+	}
+	m.Fields = append(m.Fields, field)
+}
+
+// Remove deletes a field.
+func (m *GamepadMapping) Remove(key string) {
+	for i, field := range m.Fields {
+		if field.Key == key {
+			m.Fields = append(m.Fields[:i], m.Fields[i+1:]...)
+			return
+		}
+	}
+}
+
+// Platform returns the value of the "platform" field, e.g. "Windows", "Mac OS X" or "Linux".
+func (m *GamepadMapping) Platform() string {
+	platform, _ := m.Get("platform")
+	return platform
+}
+
+// Bindings returns all fields that bind a gamepad element. Fields with an empty or invalid source are skipped.
+func (m *GamepadMapping) Bindings() []GamepadMappingBinding {
+	var bindings []GamepadMappingBinding
+	for _, field := range m.Fields {
+		target, half, ok := parseBindingKey(field.Key)
+		if !ok {
+			continue
+		}
+		source, err := ParseGamepadMappingSource(field.Value)
+		if err != nil {
+			continue
+		}
+		bindings = append(bindings, GamepadMappingBinding{Target: target, TargetHalf: half, Source: source})
+	}
+	return bindings
+}
+
+// SetBinding adds or replaces the binding of a gamepad element.
+func (m *GamepadMapping) SetBinding(binding GamepadMappingBinding) error {
+	if _, _, ok := parseBindingKey(binding.Key()); !ok {
+		return fmt.Errorf("sdl: %q is not a gamepad element", binding.Key())
+	}
+	m.Set(binding.Key(), binding.Source.String())
+	return nil
+}
+
+// MatchesGUID reports whether the mapping applies to a joystick with the given GUID.
+// Like SDL, a mapping without a name checksum (CRC16) matches joysticks with any checksum.
+func (m *GamepadMapping) MatchesGUID(guid GUID) bool {
+	own, err := ParseGUID(m.GUID)
+	if err != nil {
+		return false
+	}
+	if own.Info().CRC16 == 0 {
+		guid.Data[2], guid.Data[3] = 0, 0
+	}
+	return own == guid
+}
+
+// Validate checks the GUID, the name and all bindings, and reports elements that are bound more than once.
+func (m *GamepadMapping) Validate() error {
+	if err := validateMappingGUID(m.GUID); err != nil {
+		return err
+	}
+	if m.Name == "" {
+		return fmt.Errorf("sdl: mapping for %s has no name", m.GUID)
+	}
+	seen := make(map[string]bool)
+	for _, field := range m.Fields {
+		if field.Key == "" {
+			return fmt.Errorf("sdl: mapping for %q contains a field without key", m.Name)
+		}
+		if seen[field.Key] {
+			return fmt.Errorf("sdl: mapping for %q contains field %q more than once", m.Name, field.Key)
+		}
+		seen[field.Key] = true
+		if _, _, ok := parseBindingKey(field.Key); ok && field.Value != "" {
+			if _, err := ParseGamepadMappingSource(field.Value); err != nil {
+				return fmt.Errorf("sdl: field %q in mapping for %q: %w", field.Key, m.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package sdl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// mappingLines are taken from gamecontrollerdb.txt.
+var mappingLines = []string{
+	"03000000de280000ff11000001000000,Steam Virtual Gamepad,a:b0,b:b1,back:b6,dpdown:h0.4,dpleft:h0.8,dpright:h0.2,dpup:h0.1,leftshoulder:b4,leftstick:b8,lefttrigger:+a2,leftx:a0,lefty:a1,rightshoulder:b5,rightstick:b9,righttrigger:-a2,rightx:a3,righty:a4,start:b7,x:b2,y:b3,platform:Windows,",
+	"050000007e0500000620000001000000,Joy-Con (L),+leftx:h0.2,+lefty:h0.4,-leftx:h0.8,-lefty:h0.1,a:b0,b:b1,back:b13,leftshoulder:b4,leftstick:b10,rightshoulder:b5,start:b8,x:b2,y:b3,platform:Mac OS X,",
+	"030000005e0400008e02000010010000,Xbox 360 Controller,a:b0,b:b1,back:b6,dpdown:h0.4,dpleft:h0.8,dpright:h0.2,dpup:h0.1,guide:b8,leftshoulder:b4,leftstick:b9,lefttrigger:a2,leftx:a0,lefty:a1,rightshoulder:b5,rightstick:b10,righttrigger:a5,rightx:a3,righty:a4,start:b7,x:b2,y:b3,platform:Linux,",
+	"03000000790000000600000000000000,G-Shark GS-GP702,a:b2,b:b1,back:b8,dpdown:+a4,dpleft:-a3,dpright:+a3,dpup:-a4,leftshoulder:b4,leftstick:b10,lefttrigger:b6,leftx:a0,lefty:a1,rightshoulder:b5,rightstick:b11,righttrigger:b7,rightx:a2,righty:a5~,start:b9,x:b3,y:b0,platform:Windows,",
+	"xinput,XInput Controller,a:b0,b:b1,back:b6,guide:b10,leftshoulder:b4,leftstick:b8,lefttrigger:a2,leftx:a0,lefty:a1,rightshoulder:b5,rightstick:b9,righttrigger:a5,rightx:a3,righty:a4,start:b7,x:b2,y:b3,",
+}
+
+func TestGamepadMappingRoundTrip(t *testing.T) {
+	mappings, err := ParseGamepadMappings(strings.NewReader("# comment\n\n" + strings.Join(mappingLines, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mappings) != len(mappingLines) {
+		t.Fatalf("parsed %d mappings, want %d", len(mappings), len(mappingLines))
+	}
+	for i, mapping := range mappings {
+		if got := mapping.String(); got != mappingLines[i] {
+			t.Errorf("round trip of %s:\ngot  %s\nwant %s", mapping.Name, got, mappingLines[i])
+		}
+		if err := mapping.Validate(); err != nil {
+			t.Errorf("validate %s: %v", mapping.Name, err)
+		}
+		var unmarshalled GamepadMapping
+		if err := unmarshalled.UnmarshalText([]byte(mappingLines[i])); err != nil || !reflect.DeepEqual(unmarshalled, mapping) {
+			t.Errorf("UnmarshalText of %s = %+v, %v", mapping.Name, unmarshalled, err)
+		}
+	}
+}
+
+func TestGamepadMappingBindings(t *testing.T) {
+	mappings := make(map[string]*GamepadMapping)
+	for _, line := range mappingLines {
+		mapping, err := ParseGamepadMapping(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mappings[mapping.Name] = &mapping
+	}
+	if steam := mappings["Steam Virtual Gamepad"]; steam.GUID != "03000000de280000ff11000001000000" || steam.Platform() != "Windows" {
+		t.Errorf("Steam Virtual Gamepad: GUID %q on %q", steam.GUID, steam.Platform())
+	}
+	if platform := mappings["Joy-Con (L)"].Platform(); platform != "Mac OS X" {
+		t.Errorf("Joy-Con (L): platform = %q", platform)
+	}
+	if platform := mappings["XInput Controller"].Platform(); platform != "" {
+		t.Errorf("XInput Controller: platform = %q", platform)
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		want GamepadMappingBinding
+	}{
+		{"Steam Virtual Gamepad", "a", GamepadMappingBinding{Target: "a", Source: GamepadMappingSource{Type: GamepadBindTypeButton}}},
+		{"Steam Virtual Gamepad", "dpleft", GamepadMappingBinding{Target: "dpleft", Source: GamepadMappingSource{Type: GamepadBindTypeHat, HatMask: 8}}},
+		// both triggers share an axis, one half each
+		{"Steam Virtual Gamepad", "lefttrigger", GamepadMappingBinding{Target: "lefttrigger", Source: GamepadMappingSource{Type: GamepadBindTypeAxis, Index: 2, Half: 1}}},
+		{"Steam Virtual Gamepad", "righttrigger", GamepadMappingBinding{Target: "righttrigger", Source: GamepadMappingSource{Type: GamepadBindTypeAxis, Index: 2, Half: -1}}},
+		// the stick is put together from the directions of a hat
+		{"Joy-Con (L)", "-leftx", GamepadMappingBinding{Target: "leftx", TargetHalf: -1, Source: GamepadMappingSource{Type: GamepadBindTypeHat, HatMask: 8}}},
+		{"Joy-Con (L)", "+lefty", GamepadMappingBinding{Target: "lefty", TargetHalf: 1, Source: GamepadMappingSource{Type: GamepadBindTypeHat, HatMask: 4}}},
+		{"G-Shark GS-GP702", "dpup", GamepadMappingBinding{Target: "dpup", Source: GamepadMappingSource{Type: GamepadBindTypeAxis, Index: 4, Half: -1}}},
+		{"G-Shark GS-GP702", "righty", GamepadMappingBinding{Target: "righty", Source: GamepadMappingSource{Type: GamepadBindTypeAxis, Index: 5, Invert: true}}},
+	}
+	for _, test := range tests {
+		var got *GamepadMappingBinding
+		mapping := mappings[test.name]
+		for _, binding := range mapping.Bindings() {
+			if binding.Key() == test.key {
+				got = &binding
+				break
+			}
+		}
+		if got == nil {
+			t.Errorf("%s: no binding %q", test.name, test.key)
+		} else if *got != test.want {
+			t.Errorf("%s: %s = %+v, want %+v", test.name, test.key, *got, test.want)
+		}
+	}
+}
+
+func TestGamepadMappingSource(t *testing.T) {
+	for _, text := range []string{"b0", "b15", "a3", "+a1", "-a2", "a5~", "+a4~", "h0.1", "h1.12"} {
+		source, err := ParseGamepadMappingSource(text)
+		if err != nil {
+			t.Errorf("parse %q: %v", text, err)
+		} else if source.String() != text {
+			t.Errorf("%q formats as %q", text, source.String())
+		}
+	}
+	for _, text := range []string{"", "b", "x0", "+b0", "b0~", "h0", "h0.0", "a-1"} {
+		if _, err := ParseGamepadMappingSource(text); err == nil {
+			t.Errorf("%q was accepted", text)
+		}
+	}
+}
+
+func TestGamepadMappingEdit(t *testing.T) {
+	mapping, err := ParseGamepadMapping(mappingLines[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mapping.SetBinding(GamepadMappingBinding{Target: "misc1", Source: GamepadMappingSource{Type: GamepadBindTypeButton, Index: 11}}); err != nil {
+		t.Fatal(err)
+	}
+	mapping.Remove("guide")
+	want := strings.Replace(mappingLines[2], "guide:b8,", "", 1)
+	want = strings.Replace(want, "platform:Linux,", "misc1:b11,platform:Linux,", 1)
+	if got := mapping.String(); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+
+	if err := mapping.SetBinding(GamepadMappingBinding{Target: "+a"}); err == nil {
+		t.Error("a half button was accepted")
+	}
+	if _, err := ParseGamepadMapping("0300,Short GUID,a:b0,"); err == nil {
+		t.Error("a short GUID was accepted")
+	}
+	if _, err := ParseGamepadMapping(mappingLines[2] + "lefttrigger:z2,"); err == nil {
+		t.Error("an invalid source was accepted")
+	}
+}
@@ -0,0 +1,308 @@
+package sdl
+
+import (
+	"math"
+	"time"
+)
+
+// Quaternion is a rotation in 3D space. The zero value is not a valid rotation, use [QuaternionIdentity].
+type Quaternion struct {
+	X, Y, Z, W float32
+}
+
+// QuaternionIdentity is the rotation that leaves every vector unchanged.
+var QuaternionIdentity = Quaternion{W: 1}
+
+// Mul returns the rotation q followed by r in the local frame of q, i.e. the product q*r.
+func (q Quaternion) Mul(r Quaternion) Quaternion {
+	return Quaternion{
+		X: q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		Y: q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		Z: q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+		W: q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+	}
+}
+
+// Conjugate returns the inverse rotation of a unit quaternion.
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{X: -q.X, Y: -q.Y, Z: -q.Z, W: q.W}
+}
+
+// Normalize returns q scaled to unit length, or [QuaternionIdentity] if q has no length.
+func (q Quaternion) Normalize() Quaternion {
+	n := float32(math.Sqrt(float64(q.X*q.X + q.Y*q.Y + q.Z*q.Z + q.W*q.W)))
+	if n == 0 {
+		return QuaternionIdentity
+	}
+	return Quaternion{X: q.X / n, Y: q.Y / n, Z: q.Z / n, W: q.W / n}
+}
+
+// Rotate applies the rotation to the vector v.
+func (q Quaternion) Rotate(v [3]float32) [3]float32 {
+	p := q.Mul(Quaternion{X: v[0], Y: v[1], Z: v[2]}).Mul(q.Conjugate())
+	return [3]float32{p.X, p.Y, p.Z}
+}
+
+const (
+	// gamepadMotionStillGyro is the angular speed in rad/s below which a gamepad is considered to be lying still.
+	gamepadMotionStillGyro = 0.06
+	// gamepadMotionStillAccel is the allowed relative deviation from [StandardGravity] while lying still.
+	gamepadMotionStillAccel = 0.05
+	// gamepadMotionStillTime is how long a gamepad must lie still before auto calibration kicks in.
+	gamepadMotionStillTime = uint64(time.Second)
+	// gamepadMotionAccelTolerance is the allowed relative deviation from [StandardGravity] for the
+	// accelerometer to be trusted as a gravity reference.
+	gamepadMotionAccelTolerance = 0.1
+	// gamepadMotionMaxStep is the largest gap between two gyro samples that is still integrated.
+	gamepadMotionMaxStep = uint64(100 * time.Millisecond)
+)
+
+// GamepadMotion fuses the gyroscope and accelerometer of a gamepad into an orientation.
+//
+// The gyro is integrated for fast, precise rotation, while the accelerometer slowly pulls the estimate
+// towards the measured gravity to stop pitch and roll from drifting. Yaw cannot be corrected that way,
+// so the gyro bias is calibrated, either explicitly with [GamepadMotion.Calibrate] or automatically whenever
+// the gamepad lies still.
+//
+// The orientation uses the gamepad sensor coordinate system: with the gamepad lying flat,
+// X points to the right, Y points up and Z points towards the player.
+//
+// Example:
+//
+//	motion := sdl.NewGamepadMotion(sdl.GetGamepadID(gamepad))
+//	motion.Enable(gamepad)
+//	motion.Calibrate(time.Second)
+//	for sdl.PollEvent(&event) {
+//		motion.HandleEvent(&event)
+//	}
+//	velocity := motion.AngularVelocity() // for gyro aiming
+//
+// The zero value tracks the gamepad with instance ID 0, starts at [QuaternionIdentity] and uses the gyro only,
+// without auto calibration. [NewGamepadMotion] enables the accelerometer correction and auto calibration.
+type GamepadMotion struct {
+	Which JoystickID
+	// AccelGain is how strongly the measured gravity corrects the orientation, in rad/s per unit of error.
+	// Set it to 0 to use the gyro only.
+	AccelGain float32
+	// AutoCalibrate enables estimating the gyro bias whenever the gamepad lies still.
+	AutoCalibrate bool
+
+	// orientation is the zero Quaternion until the first rotation, which stands for QuaternionIdentity.
+	orientation Quaternion
+	gyro        [3]float64
+	accel       [3]float64
+	hasAccel    bool
+	bias        [3]float64
+	lastGyro    uint64
+
+	calibrateFor   uint64
+	calibrateStart uint64
+	calibrateSum   [3]float64
+	calibrateCount int
+
+	stillSince uint64
+	stillSum   [3]float64
+	stillCount int
+}
+
+// NewGamepadMotion creates a motion tracker for the gamepad with the given instance ID
+// with auto calibration enabled.
+func NewGamepadMotion(which JoystickID) *GamepadMotion {
+	return &GamepadMotion{
+		Which:         which,
+		AccelGain:     0.5,
+		AutoCalibrate: true,
+	}
+}
+
+// Enable turns on the gyroscope and accelerometer of the gamepad and reports whether both are available.
+func (m *GamepadMotion) Enable(gamepad *Gamepad) bool {
+	gyro := SetGamepadSensorEnabled(gamepad, SensorGyro, true)
+	accel := SetGamepadSensorEnabled(gamepad, SensorAccel, true)
+	return gyro && accel
+}
+
+// Reset sets the orientation back to [QuaternionIdentity]. The gyro bias is kept.
+func (m *GamepadMotion) Reset() {
+	m.orientation = QuaternionIdentity
+	m.lastGyro = 0
+}
+
+// Calibrate measures the gyro bias over the given duration of sensor time. The gamepad should lie still meanwhile.
+func (m *GamepadMotion) Calibrate(d time.Duration) {
+	m.calibrateFor = uint64(d)
+	m.calibrateStart = 0
+	m.calibrateSum = [3]float64{}
+	m.calibrateCount = 0
+}
+
+// Calibrating reports whether a calibration started with [GamepadMotion.Calibrate] is still running.
+func (m *GamepadMotion) Calibrating() bool {
+	return m.calibrateFor > 0
+}
+
+// GyroBias returns the gyro offset in rad/s that is subtracted from every gyro sample.
+func (m *GamepadMotion) GyroBias() [3]float32 {
+	return [3]float32{float32(m.bias[0]), float32(m.bias[1]), float32(m.bias[2])}
+}
+
+// SetGyroBias sets the gyro offset, for example to restore a previous calibration.
+func (m *GamepadMotion) SetGyroBias(bias [3]float32) {
+	m.bias = [3]float64{float64(bias[0]), float64(bias[1]), float64(bias[2])}
+}
+
+// Orientation returns the estimated rotation from gamepad space to world space.
+func (m *GamepadMotion) Orientation() Quaternion {
+	if m.orientation == (Quaternion{}) {
+		return QuaternionIdentity
+	}
+	return m.orientation
+}
+
+// AngularVelocity returns the calibrated angular velocity of the most recent gyro sample in rad/s,
+// as rotation around the X (pitch), Y (yaw) and Z (roll) axes of the gamepad.
+func (m *GamepadMotion) AngularVelocity() [3]float32 {
+	return [3]float32{
+		float32(m.gyro[0] - m.bias[0]),
+		float32(m.gyro[1] - m.bias[1]),
+		float32(m.gyro[2] - m.bias[2]),
+	}
+}
+
+// Gravity returns the unit vector pointing up in gamepad space according to the current orientation.
+func (m *GamepadMotion) Gravity() [3]float32 {
+	return m.Orientation().Conjugate().Rotate([3]float32{0, 1, 0})
+}
+
+// HandleEvent processes [EventGamepadSensorUpdate] events of the tracked gamepad and reports whether the event was used.
+func (m *GamepadMotion) HandleEvent(event *Event) bool {
+	if event.Type() != EventGamepadSensorUpdate {
+		return false
+	}
+	sensor := event.GSensor()
+	return m.HandleSensor(&sensor)
+}
+
+// HandleSensor processes a gyroscope or accelerometer sample of the tracked gamepad and reports whether it was used.
+func (m *GamepadMotion) HandleSensor(event *GamepadSensorEvent) bool {
+	if event.Which != m.Which {
+		return false
+	}
+	timestamp := event.SensorTimestamp
+	if timestamp == 0 {
+		timestamp = event.Timestamp
+	}
+	data := [3]float64{float64(event.Data[0]), float64(event.Data[1]), float64(event.Data[2])}
+	switch SensorType(event.Sensor) {
+	case SensorAccel:
+		m.accel = data
+		m.hasAccel = true
+	case SensorGyro:
+		m.gyro = data
+		m.calibrate(timestamp)
+		m.integrate(timestamp)
+	default:
+		return false
+	}
+	return true
+}
+
+func (m *GamepadMotion) calibrate(timestamp uint64) {
+	if m.calibrateFor > 0 {
+		if m.calibrateStart == 0 {
+			m.calibrateStart = timestamp
+		}
+		for i := range m.gyro {
+			m.calibrateSum[i] += m.gyro[i]
+		}
+		m.calibrateCount++
+		if timestamp-m.calibrateStart >= m.calibrateFor {
+			for i := range m.bias {
+				m.bias[i] = m.calibrateSum[i] / float64(m.calibrateCount)
+			}
+			m.calibrateFor = 0
+		}
+		return
+	}
+
+	if !m.AutoCalibrate {
+		return
+	}
+	if !m.still() {
+		m.stillSince = 0
+		m.stillSum = [3]float64{}
+		m.stillCount = 0
+		return
+	}
+	if m.stillSince == 0 {
+		m.stillSince = timestamp
+	}
+	for i := range m.gyro {
+		m.stillSum[i] += m.gyro[i]
+	}
+	m.stillCount++
+	if timestamp-m.stillSince >= gamepadMotionStillTime {
+		for i := range m.bias {
+			m.bias[i] = m.stillSum[i] / float64(m.stillCount)
+		}
+	}
+}
+
+// still reports whether the latest samples look like the gamepad is lying still.
+func (m *GamepadMotion) still() bool {
+	if vecLength(vecSub(m.gyro, m.bias)) > gamepadMotionStillGyro {
+		return false
+	}
+	if m.hasAccel && math.Abs(vecLength(m.accel)/StandardGravity-1) > gamepadMotionStillAccel {
+		return false
+	}
+	return true
+}
+
+func (m *GamepadMotion) integrate(timestamp uint64) {
+	last := m.lastGyro
+	m.lastGyro = timestamp
+	if last == 0 || timestamp <= last || timestamp-last > gamepadMotionMaxStep {
+		return
+	}
+	dt := float64(timestamp-last) / float64(time.Second)
+	omega := vecSub(m.gyro, m.bias)
+
+	if m.AccelGain > 0 && m.hasAccel {
+		length := vecLength(m.accel)
+		if math.Abs(length/StandardGravity-1) < gamepadMotionAccelTolerance {
+			measured := [3]float64{m.accel[0] / length, m.accel[1] / length, m.accel[2] / length}
+			g := m.Gravity()
+			estimated := [3]float64{float64(g[0]), float64(g[1]), float64(g[2])}
+			correction := vecCross(measured, estimated)
+			for i := range omega {
+				omega[i] += float64(m.AccelGain) * correction[i]
+			}
+		}
+	}
+
+	angle := vecLength(omega) * dt
+	if angle == 0 {
+		return
+	}
+	s := math.Sin(angle/2) / vecLength(omega)
+	step := Quaternion{
+		X: float32(omega[0] * s),
+		Y: float32(omega[1] * s),
+		Z: float32(omega[2] * s),
+		W: float32(math.Cos(angle / 2)),
+	}
+	m.orientation = m.Orientation().Mul(step).Normalize()
+}
+
+func vecSub(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func vecCross(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+
+func vecLength(v [3]float64) float64 {
+	return math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])
+}
@@ -0,0 +1,84 @@
+package sdl
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// feedMotion sends gyro and accelerometer samples at 1 kHz for the given duration, starting at *timestamp.
+func feedMotion(m *GamepadMotion, timestamp *uint64, d time.Duration, gyro, accel [3]float32) {
+	for end := *timestamp + uint64(d); *timestamp < end; *timestamp += uint64(time.Millisecond) {
+		m.HandleSensor(&GamepadSensorEvent{Which: m.Which, Sensor: int32(SensorAccel), Data: accel, SensorTimestamp: *timestamp})
+		m.HandleSensor(&GamepadSensorEvent{Which: m.Which, Sensor: int32(SensorGyro), Data: gyro, SensorTimestamp: *timestamp})
+	}
+}
+
+// quaternionAngle returns the angle in radians between two rotations.
+func quaternionAngle(a, b Quaternion) float64 {
+	dot := math.Abs(float64(a.X*b.X + a.Y*b.Y + a.Z*b.Z + a.W*b.W))
+	return 2 * math.Acos(math.Min(1, dot))
+}
+
+var flatGravity = [3]float32{0, StandardGravity, 0}
+
+func TestGamepadMotionGyro(t *testing.T) {
+	// the zero value integrates the gyro without correction
+	var m GamepadMotion
+	if m.Orientation() != QuaternionIdentity {
+		t.Fatalf("zero value orientation = %+v", m.Orientation())
+	}
+	timestamp := uint64(time.Second)
+	feedMotion(&m, &timestamp, time.Second, [3]float32{0, math.Pi / 2, 0}, flatGravity)
+
+	want := Quaternion{Y: float32(math.Sin(math.Pi / 4)), W: float32(math.Cos(math.Pi / 4))}
+	if angle := quaternionAngle(m.Orientation(), want); angle > 0.01 {
+		t.Errorf("orientation after turning 90° around Y = %+v, %.3f rad off", m.Orientation(), angle)
+	}
+}
+
+func TestGamepadMotionAccelCorrection(t *testing.T) {
+	m := NewGamepadMotion(1)
+	m.AutoCalibrate = false
+	// start with a wrong estimate, tilted by 0.5 rad around Z
+	m.orientation = Quaternion{Z: float32(math.Sin(0.25)), W: float32(math.Cos(0.25))}
+
+	timestamp := uint64(time.Second)
+	feedMotion(m, &timestamp, 10*time.Second, [3]float32{}, flatGravity)
+	if angle := quaternionAngle(m.Orientation(), QuaternionIdentity); angle > 0.01 {
+		t.Errorf("orientation didn't converge towards gravity, still %.3f rad off", angle)
+	}
+	if g := m.Gravity(); math.Abs(float64(g[1])-1) > 0.001 {
+		t.Errorf("Gravity = %v, want [0 1 0]", g)
+	}
+}
+
+func TestGamepadMotionCalibration(t *testing.T) {
+	bias := [3]float32{0.01, -0.02, 0.015}
+	for _, auto := range []bool{false, true} {
+		m := NewGamepadMotion(1)
+		m.AutoCalibrate = auto
+		if !auto {
+			m.Calibrate(500 * time.Millisecond)
+		}
+		timestamp := uint64(time.Second)
+		feedMotion(m, &timestamp, 2*time.Second, bias, flatGravity)
+		if m.Calibrating() {
+			t.Fatal("calibration didn't finish")
+		}
+		got := m.GyroBias()
+		for i := range bias {
+			if math.Abs(float64(got[i]-bias[i])) > 1e-6 {
+				t.Fatalf("auto %v: GyroBias = %v, want %v", auto, got, bias)
+			}
+		}
+
+		// once calibrated, the bias doesn't make the orientation drift
+		m.Reset()
+		m.AccelGain = 0
+		feedMotion(m, &timestamp, 10*time.Second, bias, flatGravity)
+		if angle := quaternionAngle(m.Orientation(), QuaternionIdentity); angle > 0.001 {
+			t.Errorf("auto %v: orientation drifted by %.4f rad", auto, angle)
+		}
+	}
+}
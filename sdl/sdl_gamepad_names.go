@@ -0,0 +1,165 @@
+package sdl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The String methods in this file don't call into SDL, so they can be used before the library is loaded
+// and in places like logging and config files. The names match the ones SDL uses in mapping strings.
+
+// gamepadTypeNames are the names returned by [GetGamepadStringForType], indexed by [GamepadType].
+var gamepadTypeNames = [GamepadTypeCount]string{
+	"unknown", "standard", "xbox360", "xboxone", "ps3", "ps4", "ps5",
+	"switchpro", "joyconleft", "joyconright", "joyconpair",
+}
+
+// gamepadButtonLabelNames are the names of the button labels, indexed by [GamepadButtonLabel].
+var gamepadButtonLabelNames = [...]string{
+	"Unknown", "A", "B", "X", "Y", "Cross", "Circle", "Square", "Triangle",
+}
+
+// String returns the mapping name of the axis, like "leftx", or a number for unknown axes.
+func (a GamepadAxis) String() string {
+	if a >= 0 && a < GamepadAxisCount {
+		return gamepadAxisNames[a]
+	}
+	if a == GamepadAxisInvalid {
+		return "invalid"
+	}
+	return strconv.Itoa(int(a))
+}
+
+func (a GamepadAxis) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *GamepadAxis) UnmarshalText(text []byte) error {
+	axis, err := ParseGamepadAxis(string(text))
+	if err != nil {
+		return err
+	}
+	*a = axis
+	return nil
+}
+
+// ParseGamepadAxis parses an axis name like "leftx" or "LeftTrigger", or an axis number.
+func ParseGamepadAxis(s string) (GamepadAxis, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if axis, ok := gamepadAxisFromName(name); ok {
+		return axis, nil
+	}
+	if n, err := strconv.ParseInt(name, 10, 32); err == nil && n >= 0 && n < int64(GamepadAxisCount) {
+		return GamepadAxis(n), nil
+	}
+	return GamepadAxisInvalid, fmt.Errorf("sdl: unknown gamepad axis %q", s)
+}
+
+// String returns the mapping name of the button, like "a" or "dpup", or a number for unknown buttons.
+//
+// Note that the names refer to the Xbox layout, use [GetGamepadButtonLabel] to find the label printed on a button.
+func (b GamepadButton) String() string {
+	if b >= 0 && b < GamepadButtonCount {
+		return gamepadButtonNames[b]
+	}
+	if b == GamepadButtonInvalid {
+		return "invalid"
+	}
+	return strconv.Itoa(int(b))
+}
+
+func (b GamepadButton) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *GamepadButton) UnmarshalText(text []byte) error {
+	button, err := ParseGamepadButton(string(text))
+	if err != nil {
+		return err
+	}
+	*b = button
+	return nil
+}
+
+// ParseGamepadButton parses a button name like "a" or "DPUp", or a button number.
+func ParseGamepadButton(s string) (GamepadButton, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if button, ok := gamepadButtonFromName(name); ok {
+		return button, nil
+	}
+	if n, err := strconv.ParseInt(name, 10, 32); err == nil && n >= 0 && n < int64(GamepadButtonCount) {
+		return GamepadButton(n), nil
+	}
+	return GamepadButtonInvalid, fmt.Errorf("sdl: unknown gamepad button %q", s)
+}
+
+// String returns the name of the gamepad type, like "ps5" or "switchpro".
+func (t GamepadType) String() string {
+	if t < GamepadTypeCount {
+		return gamepadTypeNames[t]
+	}
+	return strconv.FormatUint(uint64(t), 10)
+}
+
+func (t GamepadType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *GamepadType) UnmarshalText(text []byte) error {
+	gamepadType, err := ParseGamepadType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = gamepadType
+	return nil
+}
+
+// ParseGamepadType parses a gamepad type name like "xboxone" or "PS4", or a type number.
+func ParseGamepadType(s string) (GamepadType, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for i, typeName := range gamepadTypeNames {
+		if name == typeName {
+			return GamepadType(i), nil
+		}
+	}
+	if n, err := strconv.ParseUint(name, 10, 32); err == nil && n < uint64(GamepadTypeCount) {
+		return GamepadType(n), nil
+	}
+	return GamepadTypeUnknown, fmt.Errorf("sdl: unknown gamepad type %q", s)
+}
+
+// String returns the name of the label, like "A" or "Cross".
+func (l GamepadButtonLabel) String() string {
+	if int(l) < len(gamepadButtonLabelNames) {
+		return gamepadButtonLabelNames[l]
+	}
+	return strconv.FormatUint(uint64(l), 10)
+}
+
+func (l GamepadButtonLabel) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+func (l *GamepadButtonLabel) UnmarshalText(text []byte) error {
+	label, err := ParseGamepadButtonLabel(string(text))
+	if err != nil {
+		return err
+	}
+	*l = label
+	return nil
+}
+
+// ParseGamepadButtonLabel parses a label name like "Cross" or "triangle", or a label number.
+func ParseGamepadButtonLabel(s string) (GamepadButtonLabel, error) {
+	name := strings.TrimSpace(s)
+	for i, labelName := range gamepadButtonLabelNames {
+		if strings.EqualFold(name, labelName) {
+			return GamepadButtonLabel(i), nil
+		}
+	}
+	if n, err := strconv.ParseUint(name, 10, 32); err == nil && n < uint64(len(gamepadButtonLabelNames)) {
+		return GamepadButtonLabel(n), nil
+	}
+	return GamepadButtonLabelUnknown, fmt.Errorf("sdl: unknown gamepad button label %q", s)
+}
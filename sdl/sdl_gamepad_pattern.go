@@ -0,0 +1,333 @@
+package sdl
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// gamepadPatternResend is how often an unchanged rumble is sent again to keep it alive.
+	gamepadPatternResend = uint64(50 * time.Millisecond)
+	// gamepadPatternRumbleMs is the duration of every rumble request. It is longer than [gamepadPatternResend],
+	// so that a pattern keeps running smoothly but stops on its own if [GamepadPatternPlayer.Update] is no longer called.
+	gamepadPatternRumbleMs = 100
+)
+
+// GamepadKeyframe is one step of a [GamepadPattern].
+//
+// Intensities range from 0 to 1. In JSON, the duration is written as a string like "150ms".
+type GamepadKeyframe struct {
+	// Duration is how long the keyframe lasts. A keyframe without duration is skipped, but its LED color is applied.
+	Duration time.Duration `json:"duration"`
+	// Low and High are the intensities of the low and high frequency rumble motors.
+	Low  float32 `json:"low,omitempty"`
+	High float32 `json:"high,omitempty"`
+	// LeftTrigger and RightTrigger are the intensities of the trigger rumble motors.
+	LeftTrigger  float32 `json:"left_trigger,omitempty"`
+	RightTrigger float32 `json:"right_trigger,omitempty"`
+	// LED is the color of the gamepad LED. If nil, the LED keeps its color.
+	LED *Color `json:"led,omitempty"`
+	// Fade blends from the previous keyframe to this one over the duration instead of switching immediately.
+	Fade bool `json:"fade,omitempty"`
+}
+
+type gamepadKeyframeJSON struct {
+	Duration     string  `json:"duration"`
+	Low          float32 `json:"low,omitempty"`
+	High         float32 `json:"high,omitempty"`
+	LeftTrigger  float32 `json:"left_trigger,omitempty"`
+	RightTrigger float32 `json:"right_trigger,omitempty"`
+	LED          *Color  `json:"led,omitempty"`
+	Fade         bool    `json:"fade,omitempty"`
+}
+
+func (k GamepadKeyframe) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gamepadKeyframeJSON{
+		Duration:     k.Duration.String(),
+		Low:          k.Low,
+		High:         k.High,
+		LeftTrigger:  k.LeftTrigger,
+		RightTrigger: k.RightTrigger,
+		LED:          k.LED,
+		Fade:         k.Fade,
+	})
+}
+
+func (k *GamepadKeyframe) UnmarshalJSON(data []byte) error {
+	var v gamepadKeyframeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var duration time.Duration
+	if v.Duration != "" {
+		d, err := time.ParseDuration(v.Duration)
+		if err != nil {
+			return fmt.Errorf("sdl: invalid keyframe duration: %w", err)
+		}
+		if d < 0 {
+			return fmt.Errorf("sdl: negative keyframe duration %q", v.Duration)
+		}
+		duration = d
+	}
+	*k = GamepadKeyframe{
+		Duration:     duration,
+		Low:          v.Low,
+		High:         v.High,
+		LeftTrigger:  v.LeftTrigger,
+		RightTrigger: v.RightTrigger,
+		LED:          v.LED,
+		Fade:         v.Fade,
+	}
+	return nil
+}
+
+// GamepadPattern is a sequence of rumble and LED keyframes, played by a [GamepadPatternPlayer].
+//
+// Example:
+//
+//	{
+//		"name": "hit",
+//		"priority": 10,
+//		"keyframes": [
+//			{"duration": "80ms", "low": 1, "high": 0.6, "led": {"R": 255}},
+//			{"duration": "300ms", "fade": true}
+//		]
+//	}
+type GamepadPattern struct {
+	Name      string            `json:"name,omitempty"`
+	Keyframes []GamepadKeyframe `json:"keyframes"`
+	// Loop repeats the pattern until it is stopped or preempted.
+	Loop bool `json:"loop,omitempty"`
+	// Priority decides whether the pattern may interrupt another one. See [GamepadPatternPlayer.Play].
+	Priority int `json:"priority,omitempty"`
+}
+
+// Duration returns the length of one run of the pattern.
+func (p *GamepadPattern) Duration() time.Duration {
+	var total time.Duration
+	for _, keyframe := range p.Keyframes {
+		total += keyframe.Duration
+	}
+	return total
+}
+
+// Sample returns the values of the pattern at the given time since it was started.
+// The returned LED is the most recent color set at that point, or nil if no keyframe has set one yet.
+// It reports false once a pattern that doesn't loop has finished.
+func (p *GamepadPattern) Sample(elapsed time.Duration) (GamepadKeyframe, bool) {
+	total := p.Duration()
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if total == 0 || (!p.Loop && elapsed >= total) {
+		return GamepadKeyframe{LED: p.lastLED(len(p.Keyframes))}, false
+	}
+	looped := p.Loop && elapsed >= total
+	elapsed %= total
+
+	for i, keyframe := range p.Keyframes {
+		if elapsed >= keyframe.Duration {
+			elapsed -= keyframe.Duration
+			continue
+		}
+
+		// the previous keyframe wraps around when looping, otherwise a pattern fades in from silence
+		var prev GamepadKeyframe
+		if i > 0 {
+			prev = p.Keyframes[i-1]
+			prev.LED = p.lastLED(i)
+		} else if looped {
+			prev = p.Keyframes[len(p.Keyframes)-1]
+			prev.LED = p.lastLED(len(p.Keyframes))
+		}
+		led := p.lastLED(i + 1)
+		if led == nil && looped {
+			led = p.lastLED(len(p.Keyframes))
+		}
+
+		sample := keyframe
+		sample.Duration = keyframe.Duration - elapsed
+		sample.LED = led
+		if keyframe.Fade {
+			t := float32(elapsed) / float32(keyframe.Duration)
+			sample.Low = lerp(prev.Low, keyframe.Low, t)
+			sample.High = lerp(prev.High, keyframe.High, t)
+			sample.LeftTrigger = lerp(prev.LeftTrigger, keyframe.LeftTrigger, t)
+			sample.RightTrigger = lerp(prev.RightTrigger, keyframe.RightTrigger, t)
+			if keyframe.LED != nil && prev.LED != nil {
+				sample.LED = &Color{
+					R: uint8(lerp(float32(prev.LED.R), float32(keyframe.LED.R), t) + 0.5),
+					G: uint8(lerp(float32(prev.LED.G), float32(keyframe.LED.G), t) + 0.5),
+					B: uint8(lerp(float32(prev.LED.B), float32(keyframe.LED.B), t) + 0.5),
+					A: keyframe.LED.A,
+				}
+			}
+		}
+		return sample, true
+	}
+	return GamepadKeyframe{}, false
+}
+
+// lastLED returns the LED color of the last of the first n keyframes that sets one.
+func (p *GamepadPattern) lastLED(n int) *Color {
+	for i := n - 1; i >= 0; i-- {
+		if p.Keyframes[i].LED != nil {
+			return p.Keyframes[i].LED
+		}
+	}
+	return nil
+}
+
+// usesTriggers reports whether any keyframe rumbles the triggers.
+func (p *GamepadPattern) usesTriggers() bool {
+	for _, keyframe := range p.Keyframes {
+		if keyframe.LeftTrigger != 0 || keyframe.RightTrigger != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func lerp(a, b, t float32) float32 {
+	return a + (b-a)*t
+}
+
+// gamepadIntensity converts an intensity from 0 to 1 into the range used by [RumbleGamepad].
+func gamepadIntensity(v float32) uint16 {
+	if v <= 0 || math.IsNaN(float64(v)) {
+		return 0
+	}
+	if v >= 1 {
+		return 0xFFFF
+	}
+	return uint16(v*0xFFFF + 0.5)
+}
+
+type gamepadPatternState struct {
+	pattern  *GamepadPattern
+	start    uint64
+	triggers bool
+
+	sent                   uint64
+	low, high, left, right uint16
+	led                    Color
+	ledSet                 bool
+}
+
+// GamepadPatternPlayer plays [GamepadPattern]s on gamepads, one at a time per gamepad.
+//
+// Call [GamepadPatternPlayer.Update] once per frame. The player is not safe for concurrent use.
+// The zero value is a player without any running patterns.
+type GamepadPatternPlayer struct {
+	states map[*Gamepad]*gamepadPatternState
+}
+
+// init creates the map of a zero value.
+func (p *GamepadPatternPlayer) init() {
+	if p.states == nil {
+		p.states = make(map[*Gamepad]*gamepadPatternState)
+	}
+}
+
+// NewGamepadPatternPlayer creates a player without any running patterns.
+func NewGamepadPatternPlayer() *GamepadPatternPlayer {
+	return &GamepadPatternPlayer{states: make(map[*Gamepad]*gamepadPatternState)}
+}
+
+// Play starts a pattern on a gamepad.
+//
+// If another pattern is running on the gamepad, it is only replaced if the new pattern has the same or a higher priority.
+// Play reports whether the pattern was started.
+func (p *GamepadPatternPlayer) Play(gamepad *Gamepad, pattern *GamepadPattern) bool {
+	p.init()
+	if state, ok := p.states[gamepad]; ok && state.pattern.Priority > pattern.Priority {
+		return false
+	}
+	state := &gamepadPatternState{
+		pattern:  pattern,
+		start:    GetTicksNS(),
+		triggers: pattern.usesTriggers(),
+	}
+	if old, ok := p.states[gamepad]; ok {
+		state.led, state.ledSet = old.led, old.ledSet
+		if old.triggers && !state.triggers {
+			RumbleGamepadTriggers(gamepad, 0, 0, 0)
+		}
+	}
+	p.states[gamepad] = state
+	p.apply(gamepad, state, state.start)
+	return true
+}
+
+// Playing returns the pattern running on a gamepad, or nil if there is none.
+func (p *GamepadPatternPlayer) Playing(gamepad *Gamepad) *GamepadPattern {
+	if state, ok := p.states[gamepad]; ok {
+		return state.pattern
+	}
+	return nil
+}
+
+// Stop stops the pattern running on a gamepad and silences its motors. The LED keeps its current color.
+func (p *GamepadPatternPlayer) Stop(gamepad *Gamepad) {
+	state, ok := p.states[gamepad]
+	if !ok {
+		return
+	}
+	delete(p.states, gamepad)
+	RumbleGamepad(gamepad, 0, 0, 0)
+	if state.triggers {
+		RumbleGamepadTriggers(gamepad, 0, 0, 0)
+	}
+}
+
+// StopAll stops the patterns on all gamepads.
+func (p *GamepadPatternPlayer) StopAll() {
+	for gamepad := range p.states {
+		p.Stop(gamepad)
+	}
+}
+
+// Forget drops a gamepad without touching it, for example after it was closed.
+func (p *GamepadPatternPlayer) Forget(gamepad *Gamepad) {
+	delete(p.states, gamepad)
+}
+
+// Update advances all running patterns and sends the new values to the gamepads.
+func (p *GamepadPatternPlayer) Update() {
+	now := GetTicksNS()
+	for gamepad, state := range p.states {
+		if !p.apply(gamepad, state, now) {
+			p.Stop(gamepad)
+		}
+	}
+}
+
+// apply sends the values of the pattern at the given time and reports whether the pattern is still running.
+func (p *GamepadPatternPlayer) apply(gamepad *Gamepad, state *gamepadPatternState, now uint64) bool {
+	sample, running := state.pattern.Sample(time.Duration(now - state.start))
+
+	if sample.LED != nil && (!state.ledSet || state.led != *sample.LED) {
+		SetGamepadLED(gamepad, sample.LED.R, sample.LED.G, sample.LED.B)
+		state.led = *sample.LED
+		state.ledSet = true
+	}
+	if !running {
+		return false
+	}
+
+	low, high := gamepadIntensity(sample.Low), gamepadIntensity(sample.High)
+	left, right := gamepadIntensity(sample.LeftTrigger), gamepadIntensity(sample.RightTrigger)
+	changed := low != state.low || high != state.high || left != state.left || right != state.right
+	if !changed && state.sent != 0 && now-state.sent < gamepadPatternResend {
+		return true
+	}
+	RumbleGamepad(gamepad, low, high, gamepadPatternRumbleMs)
+	if state.triggers {
+		RumbleGamepadTriggers(gamepad, left, right, gamepadPatternRumbleMs)
+	}
+	state.low, state.high, state.left, state.right = low, high, left, right
+	state.sent = now
+	return true
+}
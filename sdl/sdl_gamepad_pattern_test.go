@@ -0,0 +1,89 @@
+package sdl
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGamepadPatternSample(t *testing.T) {
+	red, blue := &Color{R: 255, A: 255}, &Color{B: 255, A: 255}
+	pattern := GamepadPattern{Keyframes: []GamepadKeyframe{
+		{Duration: 100 * time.Millisecond, Low: 1, LED: red, Fade: true},
+		{Duration: 200 * time.Millisecond, High: 1, LED: blue, Fade: true},
+	}}
+
+	tests := []struct {
+		loop      bool
+		elapsed   time.Duration
+		low, high float32
+		led       Color
+		running   bool
+	}{
+		// the first run fades in from silence
+		{false, 0, 0, 0, *red, true},
+		{false, 50 * time.Millisecond, 0.5, 0, *red, true},
+		{false, 200 * time.Millisecond, 0.5, 0.5, Color{R: 128, B: 128, A: 255}, true},
+		{false, 300 * time.Millisecond, 0, 0, *blue, false},
+		// a loop fades from the last keyframe back into the first one, including the LED
+		{true, 350 * time.Millisecond, 0.5, 0.5, Color{R: 128, B: 128, A: 255}, true},
+		{true, 950 * time.Millisecond, 0.5, 0.5, Color{R: 128, B: 128, A: 255}, true},
+		{true, 400 * time.Millisecond, 1, 0, *red, true},
+		{true, 500 * time.Millisecond, 0.5, 0.5, Color{R: 128, B: 128, A: 255}, true},
+	}
+	for _, test := range tests {
+		pattern.Loop = test.loop
+		sample, running := pattern.Sample(test.elapsed)
+		if running != test.running || math.Abs(float64(sample.Low-test.low)) > 1e-6 || math.Abs(float64(sample.High-test.high)) > 1e-6 {
+			t.Errorf("loop %v, %v: low %v, high %v, running %v, want %v, %v, %v",
+				test.loop, test.elapsed, sample.Low, sample.High, running, test.low, test.high, test.running)
+		}
+		if sample.LED == nil || *sample.LED != test.led {
+			t.Errorf("loop %v, %v: LED %+v, want %+v", test.loop, test.elapsed, sample.LED, test.led)
+		}
+	}
+}
+
+func TestGamepadPatternJSON(t *testing.T) {
+	var keyframe GamepadKeyframe
+	if err := keyframe.UnmarshalJSON([]byte(`{"duration": "80ms", "low": 1, "fade": true}`)); err != nil {
+		t.Fatal(err)
+	}
+	if keyframe.Duration != 80*time.Millisecond || keyframe.Low != 1 || !keyframe.Fade {
+		t.Errorf("got %+v", keyframe)
+	}
+	if err := keyframe.UnmarshalJSON([]byte(`{"duration": "-1s"}`)); err == nil {
+		t.Error("a negative duration was accepted")
+	}
+}
+
+func TestGamepadPatternPlayerPriority(t *testing.T) {
+	background := &GamepadPattern{Name: "engine", Loop: true, Priority: 1, Keyframes: []GamepadKeyframe{{Duration: time.Second, Low: 0.2}}}
+	hit := &GamepadPattern{Name: "hit", Priority: 10, Keyframes: []GamepadKeyframe{{Duration: time.Second, Low: 1}}}
+	explosion := &GamepadPattern{Name: "explosion", Priority: 10, Keyframes: []GamepadKeyframe{{Duration: time.Second, High: 1}}}
+
+	// the zero value is ready to use, a nil gamepad is enough as the SDL calls only fail
+	var player GamepadPatternPlayer
+	if player.Playing(nil) != nil {
+		t.Fatal("the zero value plays a pattern")
+	}
+	player.Update()
+	player.Stop(nil)
+
+	if !player.Play(nil, background) || player.Playing(nil) != background {
+		t.Fatal("the first pattern didn't start")
+	}
+	if !player.Play(nil, hit) || player.Playing(nil) != hit {
+		t.Error("a higher priority didn't preempt a lower one")
+	}
+	if player.Play(nil, background) || player.Playing(nil) != hit {
+		t.Error("a lower priority preempted a higher one")
+	}
+	if !player.Play(nil, explosion) || player.Playing(nil) != explosion {
+		t.Error("the same priority didn't preempt")
+	}
+	player.Stop(nil)
+	if player.Playing(nil) != nil {
+		t.Error("the pattern is still playing after Stop")
+	}
+}
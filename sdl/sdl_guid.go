@@ -1,9 +1,152 @@
 package sdl
 
-// func GUIDToString(guid GUID, pszGUID string, cbGUID int32)  {
-//	sdlGUIDToString(guid, pszGUID, cbGUID)
-// }
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+	"strings"
+	"unsafe"
 
-// func StringToGUID(pchGUID string) GUID {
-//	return sdlStringToGUID(pchGUID)
-// }
+	"github.com/ebitengine/purego"
+)
+
+// GUID is a 128-bit identifier for an input device that identifies that device across runs of SDL programs on the same platform.
+type GUID struct {
+	Data [16]uint8
+}
+
+// HardwareBus is the bus a joystick is connected through, as stored in the first two bytes of its [GUID].
+type HardwareBus uint16
+
+const (
+	HardwareBusUnknown   HardwareBus = 0x00
+	HardwareBusUSB       HardwareBus = 0x03
+	HardwareBusBluetooth HardwareBus = 0x05
+	HardwareBusVirtual   HardwareBus = 0xFF
+)
+
+// GUIDInfo is the device information encoded in a joystick [GUID].
+type GUIDInfo struct {
+	Vendor  uint16
+	Product uint16
+	Version uint16
+	// CRC16 is the checksum of the joystick name, or 0 if unknown.
+	CRC16 uint16
+	Bus   HardwareBus
+}
+
+const (
+	// guidByReference is true if the C ABI passes 16 byte structs by reference, which Windows x64 does.
+	// The pointer has to be converted in the argument list of purego.SyscallN to keep the GUID alive.
+	guidByReference = runtime.GOOS == "windows" && runtime.GOARCH == "amd64"
+	// guidWordCount is the number of arguments a GUID passed by value takes: two integer registers
+	// on the other 64-bit ABIs and four words on the 32-bit ABIs, on the stack on x86 and in r0-r3 on ARM.
+	guidWordCount = 16 / unsafe.Sizeof(uintptr(0))
+)
+
+// guidWords splits a GUID into the first guidWordCount arguments needed to pass it by value to a C function.
+func guidWords(guid *GUID) [4]uintptr {
+	var words [4]uintptr
+	if guidWordCount == 4 {
+		for i := range words {
+			words[i] = uintptr(binary.LittleEndian.Uint32(guid.Data[4*i:]))
+		}
+		return words
+	}
+	words[0] = uintptr(binary.LittleEndian.Uint64(guid.Data[:8]))
+	words[1] = uintptr(binary.LittleEndian.Uint64(guid.Data[8:]))
+	return words
+}
+
+// guidCall calls a C function that takes a single integer or pointer argument and returns a GUID by value.
+// Windows x64 and the 32-bit ABIs return it through a hidden pointer argument, the other 64-bit ABIs
+// in two integer registers.
+func guidCall(fn uintptr, arg uintptr) GUID {
+	var guid GUID
+	if guidByReference || guidWordCount == 4 {
+		purego.SyscallN(fn, uintptr(unsafe.Pointer(&guid)), arg)
+		return guid
+	}
+	r1, r2, _ := purego.SyscallN(fn, arg)
+	binary.LittleEndian.PutUint64(guid.Data[:8], uint64(r1))
+	binary.LittleEndian.PutUint64(guid.Data[8:], uint64(r2))
+	return guid
+}
+
+// ParseGUID parses the 32 hex digit form of a GUID used in mapping strings, like "030000005e0400008e02000014010000".
+func ParseGUID(s string) (GUID, error) {
+	var guid GUID
+	if len(s) != 2*len(guid.Data) {
+		return guid, fmt.Errorf("sdl: GUID %q must have %d hex digits", s, 2*len(guid.Data))
+	}
+	if _, err := hex.Decode(guid.Data[:], []byte(s)); err != nil {
+		return GUID{}, fmt.Errorf("sdl: invalid GUID %q", s)
+	}
+	return guid, nil
+}
+
+// String returns the GUID as 32 lowercase hex digits, the same as [GUIDToString].
+func (g GUID) String() string {
+	return hex.EncodeToString(g.Data[:])
+}
+
+func (g GUID) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *GUID) UnmarshalText(text []byte) error {
+	guid, err := ParseGUID(strings.TrimSpace(string(text)))
+	if err != nil {
+		return err
+	}
+	*g = guid
+	return nil
+}
+
+// IsZero reports whether all bytes of the GUID are zero, which SDL uses for an invalid GUID.
+func (g GUID) IsZero() bool {
+	return g == GUID{}
+}
+
+// Info decodes the vendor, product, version, name checksum and bus from a joystick GUID.
+//
+// This is the same as [GetJoystickGUIDInfo] plus the bus, but doesn't require SDL to be loaded.
+// Fields that aren't part of the GUID are 0.
+func (g GUID) Info() GUIDInfo {
+	word := func(i int) uint16 {
+		return binary.LittleEndian.Uint16(g.Data[2*i:])
+	}
+	info := GUIDInfo{Bus: HardwareBus(word(0))}
+	if info.Bus >= ' ' && info.Bus != HardwareBusVirtual {
+		return GUIDInfo{}
+	}
+	info.CRC16 = word(1)
+	// the vendor and product are only present if the padding next to them is zero,
+	// otherwise that space holds the start of the joystick name
+	if word(3) == 0 && word(5) == 0 {
+		info.Vendor = word(2)
+		info.Product = word(4)
+		info.Version = word(6)
+	}
+	return info
+}
+
+// [GUIDToString] gets an ASCII string representation for a given [GUID].
+//
+// This is implemented in Go and equivalent to [GUID.String].
+//
+// [GUIDToString]: https://wiki.libsdl.org/SDL3/SDL_GUIDToString
+func GUIDToString(guid GUID) string {
+	return guid.String()
+}
+
+// [StringToGUID] converts a GUID string into a [GUID] structure.
+//
+// Like SDL, it is lenient and returns a zero GUID for invalid input. Use [ParseGUID] to get an error instead.
+//
+// [StringToGUID]: https://wiki.libsdl.org/SDL3/SDL_StringToGUID
+func StringToGUID(pchGUID string) GUID {
+	guid, _ := ParseGUID(pchGUID)
+	return guid
+}
@@ -0,0 +1,89 @@
+package sdl
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseGUID(t *testing.T) {
+	const text = "030000005e0400008e02000014010000"
+	guid, err := ParseGUID(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if guid.Data[0] != 0x03 || guid.Data[4] != 0x5e || guid.Data[15] != 0 {
+		t.Errorf("ParseGUID(%q) = %x", text, guid.Data)
+	}
+	if guid.String() != text || GUIDToString(guid) != text {
+		t.Errorf("String = %q, want %q", guid.String(), text)
+	}
+	if upper, err := ParseGUID("030000005E0400008E02000014010000"); err != nil || upper != guid {
+		t.Errorf("uppercase digits = %x, %v", upper.Data, err)
+	}
+
+	for _, invalid := range []string{"", "0300", text + "00", "030000005e0400008e0200001401000g"} {
+		if _, err := ParseGUID(invalid); err == nil {
+			t.Errorf("ParseGUID(%q) succeeded", invalid)
+		}
+		if !StringToGUID(invalid).IsZero() {
+			t.Errorf("StringToGUID(%q) isn't zero", invalid)
+		}
+	}
+}
+
+func TestGUIDText(t *testing.T) {
+	var v struct {
+		GUID GUID `json:"guid"`
+	}
+	if err := json.Unmarshal([]byte(`{"guid": " 050000004c050000cc09000000810000 "}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil || string(data) != `{"guid":"050000004c050000cc09000000810000"}` {
+		t.Errorf("Marshal = %s, %v", data, err)
+	}
+	if err := v.GUID.UnmarshalText([]byte("not a guid")); err == nil {
+		t.Error("UnmarshalText accepted an invalid GUID")
+	}
+	if v.GUID.String() != "050000004c050000cc09000000810000" {
+		t.Error("a failed UnmarshalText changed the GUID")
+	}
+}
+
+func TestGUIDInfo(t *testing.T) {
+	tests := []struct {
+		guid string
+		want GUIDInfo
+	}{
+		// Xbox 360 controller over USB without name checksum
+		{"030000005e0400008e02000014010000", GUIDInfo{Bus: HardwareBusUSB, Vendor: 0x045e, Product: 0x028e, Version: 0x0114}},
+		// DualShock 4 over Bluetooth with name checksum
+		{"0500b4524c050000cc09000000810000", GUIDInfo{Bus: HardwareBusBluetooth, CRC16: 0x52b4, Vendor: 0x054c, Product: 0x09cc, Version: 0x8100}},
+		// a device without vendor and product stores the start of its name instead
+		{"050000004a6f792d436f6e20284c2900", GUIDInfo{Bus: HardwareBusBluetooth}},
+		// a GUID that doesn't start with a bus, like the ones of the XInput fallback mapping
+		{"78696e70757400000000000000000000", GUIDInfo{}},
+	}
+	for _, test := range tests {
+		guid, err := ParseGUID(test.guid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := guid.Info(); got != test.want {
+			t.Errorf("%s: Info = %+v, want %+v", test.guid, got, test.want)
+		}
+	}
+}
+
+func TestGUIDWords(t *testing.T) {
+	guid, _ := ParseGUID("0102030405060708090a0b0c0d0e0f10")
+	words := guidWords(&guid)
+	lo, hi := uint64(0x08070605_04030201), uint64(0x100f0e0d_0c0b0a09)
+	want := [4]uintptr{uintptr(lo), uintptr(hi)}
+	if guidWordCount == 4 {
+		want = [4]uintptr{0x04030201, 0x08070605, 0x0c0b0a09, 0x100f0e0d}
+	}
+	if words != want {
+		t.Errorf("guidWords = %#x, want %#x", words, want)
+	}
+}
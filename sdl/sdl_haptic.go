@@ -1,125 +1,538 @@
 package sdl
 
-// func CloseHaptic(haptic *Haptic)  {
-//	sdlCloseHaptic(haptic)
-// }
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
 
-// func CreateHapticEffect(haptic *Haptic, effect *HapticEffect) int32 {
-//	return sdlCreateHapticEffect(haptic, effect)
-// }
+	"github.com/jupiterrider/purego-sdl3/internal/mem"
+)
 
-// func DestroyHapticEffect(haptic *Haptic, effect int32)  {
-//	sdlDestroyHapticEffect(haptic, effect)
-// }
+// Haptic is an opened haptic (force feedback) device, see [OpenHaptic].
+type Haptic struct{}
 
-// func GetHapticEffectStatus(haptic *Haptic, effect int32) bool {
-//	return sdlGetHapticEffectStatus(haptic, effect)
-// }
+// HapticID is the instance ID of a haptic device. It is never reused for the lifetime of the application.
+type HapticID uint32
 
-// func GetHapticFeatures(haptic *Haptic) uint32 {
-//	return sdlGetHapticFeatures(haptic)
-// }
+// HapticEffectType is the type of a haptic effect. The values are also used as bits in [GetHapticFeatures].
+type HapticEffectType uint16
 
-// func GetHapticFromID(instance_id HapticID) *Haptic {
-//	return sdlGetHapticFromID(instance_id)
-// }
+const (
+	HapticEffectConstant HapticEffectType = 1 << iota
+	HapticEffectSine
+	HapticEffectSquare
+	HapticEffectTriangle
+	HapticEffectSawtoothUp
+	HapticEffectSawtoothDown
+	HapticEffectRamp
+	HapticEffectSpring
+	HapticEffectDamper
+	HapticEffectInertia
+	HapticEffectFriction
+	HapticEffectLeftRight
+	_
+	_
+	_
+	HapticEffectCustom
+)
 
-// func GetHapticID(haptic *Haptic) HapticID {
-//	return sdlGetHapticID(haptic)
-// }
+// Device features reported by [GetHapticFeatures] besides the supported [HapticEffectType]s.
+const (
+	HapticGain uint32 = 1 << (16 + iota)
+	HapticAutocenter
+	HapticStatus
+	HapticPause
+)
 
-// func GetHapticName(haptic *Haptic) string {
-//	return sdlGetHapticName(haptic)
-// }
+// HapticInfinity can be used as effect length or iteration count to repeat an effect forever.
+const HapticInfinity uint32 = 4294967295
 
-// func GetHapticNameForID(instance_id HapticID) string {
-//	return sdlGetHapticNameForID(instance_id)
-// }
+type HapticDirectionType uint8
 
-// func GetHaptics(count *int32) *HapticID {
-//	return sdlGetHaptics(count)
-// }
+const (
+	HapticPolar HapticDirectionType = iota
+	HapticCartesian
+	HapticSpherical
+	HapticSteeringAxis
+)
 
-// func GetMaxHapticEffects(haptic *Haptic) int32 {
-//	return sdlGetMaxHapticEffects(haptic)
-// }
+// HapticDirection is the direction of a haptic effect. The meaning of Dir depends on Type, see the SDL documentation.
+type HapticDirection struct {
+	Type HapticDirectionType
+	_    [3]byte
+	Dir  [3]int32
+}
 
-// func GetMaxHapticEffectsPlaying(haptic *Haptic) int32 {
-//	return sdlGetMaxHapticEffectsPlaying(haptic)
-// }
+// HapticConstant is a constant force, see [ConstantEffect].
+type HapticConstant struct {
+	Type         HapticEffectType
+	_            [2]byte
+	Direction    HapticDirection
+	Length       uint32
+	Delay        uint16
+	Button       uint16
+	Interval     uint16
+	Level        int16
+	AttackLength uint16
+	AttackLevel  uint16
+	FadeLength   uint16
+	FadeLevel    uint16
+}
 
-// func GetNumHapticAxes(haptic *Haptic) int32 {
-//	return sdlGetNumHapticAxes(haptic)
-// }
+// HapticPeriodic is a wave shaped force, see [PeriodicEffect].
+type HapticPeriodic struct {
+	Type         HapticEffectType
+	_            [2]byte
+	Direction    HapticDirection
+	Length       uint32
+	Delay        uint16
+	Button       uint16
+	Interval     uint16
+	Period       uint16
+	Magnitude    int16
+	Offset       int16
+	Phase        uint16
+	AttackLength uint16
+	AttackLevel  uint16
+	FadeLength   uint16
+	FadeLevel    uint16
+	_            [2]byte
+}
 
-// func HapticEffectSupported(haptic *Haptic, effect *HapticEffect) bool {
-//	return sdlHapticEffectSupported(haptic, effect)
-// }
+// HapticCondition is a force that depends on the position or movement of an axis, see [ConditionEffect].
+// The arrays hold one value per axis.
+type HapticCondition struct {
+	Type       HapticEffectType
+	_          [2]byte
+	Direction  HapticDirection
+	Length     uint32
+	Delay      uint16
+	Button     uint16
+	Interval   uint16
+	RightSat   [3]uint16
+	LeftSat    [3]uint16
+	RightCoeff [3]int16
+	LeftCoeff  [3]int16
+	Deadband   [3]uint16
+	Center     [3]int16
+	_          [2]byte
+}
 
-// func HapticRumbleSupported(haptic *Haptic) bool {
-//	return sdlHapticRumbleSupported(haptic)
-// }
+// HapticRamp is a force that changes linearly from Start to End, see [RampEffect].
+type HapticRamp struct {
+	Type         HapticEffectType
+	_            [2]byte
+	Direction    HapticDirection
+	Length       uint32
+	Delay        uint16
+	Button       uint16
+	Interval     uint16
+	Start        int16
+	End          int16
+	AttackLength uint16
+	AttackLevel  uint16
+	FadeLength   uint16
+	FadeLevel    uint16
+	_            [2]byte
+}
 
-// func InitHapticRumble(haptic *Haptic) bool {
-//	return sdlInitHapticRumble(haptic)
-// }
+// HapticLeftRight controls the two motors of a gamepad style device, see [LeftRightEffect].
+type HapticLeftRight struct {
+	Type           HapticEffectType
+	_              [2]byte
+	Length         uint32
+	LargeMagnitude uint16
+	SmallMagnitude uint16
+}
 
-// func IsJoystickHaptic(joystick *Joystick) bool {
-//	return sdlIsJoystickHaptic(joystick)
-// }
+// HapticCustom plays user defined samples, see [CustomEffect].
+type HapticCustom struct {
+	Type         HapticEffectType
+	_            [2]byte
+	Direction    HapticDirection
+	Length       uint32
+	Delay        uint16
+	Button       uint16
+	Interval     uint16
+	Channels     uint8
+	_            uint8
+	Period       uint16
+	Samples      uint16
+	Data         *uint16
+	AttackLength uint16
+	AttackLevel  uint16
+	FadeLength   uint16
+	FadeLevel    uint16
+}
 
-// func IsMouseHaptic() bool {
-//	return sdlIsMouseHaptic()
-// }
+// The pointer in SDL_HapticCustom is aligned to the pointer size, so its offset and the struct size depend on it.
+const (
+	hapticCustomDataOffset = (36 + unsafe.Sizeof(uintptr(0)) - 1) &^ (unsafe.Sizeof(uintptr(0)) - 1)
+	hapticCustomSize       = hapticCustomDataOffset + unsafe.Sizeof(uintptr(0)) + 8
+)
 
-// func OpenHaptic(instance_id HapticID) *Haptic {
-//	return sdlOpenHaptic(instance_id)
-// }
+// The effect structs must match the C layout, which is checked at compile time.
+var (
+	_ [16]byte                     = [unsafe.Sizeof(HapticDirection{})]byte{}
+	_ [40]byte                     = [unsafe.Sizeof(HapticConstant{})]byte{}
+	_ [48]byte                     = [unsafe.Sizeof(HapticPeriodic{})]byte{}
+	_ [68]byte                     = [unsafe.Sizeof(HapticCondition{})]byte{}
+	_ [44]byte                     = [unsafe.Sizeof(HapticRamp{})]byte{}
+	_ [12]byte                     = [unsafe.Sizeof(HapticLeftRight{})]byte{}
+	_ [hapticCustomSize]byte       = [unsafe.Sizeof(HapticCustom{})]byte{}
+	_ [hapticCustomDataOffset]byte = [unsafe.Offsetof(HapticCustom{}.Data)]byte{}
+)
 
-// func OpenHapticFromJoystick(joystick *Joystick) *Haptic {
-//	return sdlOpenHapticFromJoystick(joystick)
-// }
+// HapticEffect is the union SDL_HapticEffect. Create it with one of the effect constructors like [ConstantEffect].
+type HapticEffect struct {
+	// raw is as large as the union on 64-bit platforms, on 32-bit platforms the union is smaller.
+	raw [9]uint64
+	// samples keeps the data of a custom effect alive, as raw only holds its address.
+	samples []uint16
+}
 
-// func OpenHapticFromMouse() *Haptic {
-//	return sdlOpenHapticFromMouse()
-// }
+func newHapticEffect[T any](effect *T) *HapticEffect {
+	e := &HapticEffect{}
+	*(*T)(unsafe.Pointer(&e.raw)) = *effect
+	return e
+}
 
-// func PauseHaptic(haptic *Haptic) bool {
-//	return sdlPauseHaptic(haptic)
-// }
+// Type returns the type of the effect.
+func (e *HapticEffect) Type() HapticEffectType {
+	return *(*HapticEffectType)(unsafe.Pointer(&e.raw))
+}
 
-// func PlayHapticRumble(haptic *Haptic, strength float32, length uint32) bool {
-//	return sdlPlayHapticRumble(haptic, strength, length)
-// }
+// Constant returns the effect as [HapticConstant]. Only valid if Type is [HapticEffectConstant].
+func (e *HapticEffect) Constant() HapticConstant {
+	return *(*HapticConstant)(unsafe.Pointer(&e.raw))
+}
 
-// func ResumeHaptic(haptic *Haptic) bool {
-//	return sdlResumeHaptic(haptic)
-// }
+// Periodic returns the effect as [HapticPeriodic]. Only valid for periodic effect types.
+func (e *HapticEffect) Periodic() HapticPeriodic {
+	return *(*HapticPeriodic)(unsafe.Pointer(&e.raw))
+}
 
-// func RunHapticEffect(haptic *Haptic, effect int32, iterations uint32) bool {
-//	return sdlRunHapticEffect(haptic, effect, iterations)
-// }
+// Condition returns the effect as [HapticCondition]. Only valid for condition effect types.
+func (e *HapticEffect) Condition() HapticCondition {
+	return *(*HapticCondition)(unsafe.Pointer(&e.raw))
+}
 
-// func SetHapticAutocenter(haptic *Haptic, autocenter int32) bool {
-//	return sdlSetHapticAutocenter(haptic, autocenter)
-// }
+// Ramp returns the effect as [HapticRamp]. Only valid if Type is [HapticEffectRamp].
+func (e *HapticEffect) Ramp() HapticRamp {
+	return *(*HapticRamp)(unsafe.Pointer(&e.raw))
+}
 
-// func SetHapticGain(haptic *Haptic, gain int32) bool {
-//	return sdlSetHapticGain(haptic, gain)
-// }
+// LeftRight returns the effect as [HapticLeftRight]. Only valid if Type is [HapticEffectLeftRight].
+func (e *HapticEffect) LeftRight() HapticLeftRight {
+	return *(*HapticLeftRight)(unsafe.Pointer(&e.raw))
+}
 
-// func StopHapticEffect(haptic *Haptic, effect int32) bool {
-//	return sdlStopHapticEffect(haptic, effect)
-// }
+// Custom returns the effect as [HapticCustom]. Only valid if Type is [HapticEffectCustom].
+func (e *HapticEffect) Custom() HapticCustom {
+	return *(*HapticCustom)(unsafe.Pointer(&e.raw))
+}
 
-// func StopHapticEffects(haptic *Haptic) bool {
-//	return sdlStopHapticEffects(haptic)
-// }
+// ConstantEffect creates a constant force effect. The type field is set automatically.
+func ConstantEffect(effect HapticConstant) *HapticEffect {
+	effect.Type = HapticEffectConstant
+	return newHapticEffect(&effect)
+}
 
-// func StopHapticRumble(haptic *Haptic) bool {
-//	return sdlStopHapticRumble(haptic)
-// }
+// PeriodicEffect creates a wave effect. waveform is one of [HapticEffectSine], [HapticEffectSquare],
+// [HapticEffectTriangle], [HapticEffectSawtoothUp] or [HapticEffectSawtoothDown].
+func PeriodicEffect(waveform HapticEffectType, effect HapticPeriodic) (*HapticEffect, error) {
+	switch waveform {
+	case HapticEffectSine, HapticEffectSquare, HapticEffectTriangle, HapticEffectSawtoothUp, HapticEffectSawtoothDown:
+	default:
+		return nil, fmt.Errorf("sdl: haptic effect type %#x is not periodic", waveform)
+	}
+	effect.Type = waveform
+	return newHapticEffect(&effect), nil
+}
 
-// func UpdateHapticEffect(haptic *Haptic, effect int32, data *HapticEffect) bool {
-//	return sdlUpdateHapticEffect(haptic, effect, data)
-// }
+// ConditionEffect creates an axis condition effect. condition is one of [HapticEffectSpring],
+// [HapticEffectDamper], [HapticEffectInertia] or [HapticEffectFriction].
+func ConditionEffect(condition HapticEffectType, effect HapticCondition) (*HapticEffect, error) {
+	switch condition {
+	case HapticEffectSpring, HapticEffectDamper, HapticEffectInertia, HapticEffectFriction:
+	default:
+		return nil, fmt.Errorf("sdl: haptic effect type %#x is not a condition", condition)
+	}
+	effect.Type = condition
+	return newHapticEffect(&effect), nil
+}
+
+// RampEffect creates a ramp effect. The type field is set automatically.
+func RampEffect(effect HapticRamp) *HapticEffect {
+	effect.Type = HapticEffectRamp
+	return newHapticEffect(&effect)
+}
+
+// LeftRightEffect creates an effect for the large (low frequency) and small (high frequency) motor of a device.
+// length is in milliseconds.
+func LeftRightEffect(largeMagnitude, smallMagnitude uint16, length uint32) *HapticEffect {
+	return newHapticEffect(&HapticLeftRight{
+		Type:           HapticEffectLeftRight,
+		Length:         length,
+		LargeMagnitude: largeMagnitude,
+		SmallMagnitude: smallMagnitude,
+	})
+}
+
+// CustomEffect creates an effect from samples, which are interleaved per channel.
+// The Type, Data and Samples fields are set from the arguments, Channels defaults to 1.
+func CustomEffect(effect HapticCustom, samples []uint16) (*HapticEffect, error) {
+	if effect.Channels == 0 {
+		effect.Channels = 1
+	}
+	channels := int(effect.Channels)
+	if len(samples) == 0 || len(samples)%channels != 0 {
+		return nil, fmt.Errorf("sdl: %d custom effect samples can't be split into %d channels", len(samples), channels)
+	}
+	if len(samples)/channels > 0xFFFF {
+		return nil, fmt.Errorf("sdl: custom effect has too many samples")
+	}
+	samples = append([]uint16(nil), samples...)
+	effect.Type = HapticEffectCustom
+	effect.Samples = uint16(len(samples) / channels)
+	effect.Data = nil
+	e := newHapticEffect(&effect)
+	e.samples = samples
+	// raw is invisible to the garbage collector, so the samples are kept alive through e.samples
+	*(*uintptr)(unsafe.Add(unsafe.Pointer(&e.raw), unsafe.Offsetof(effect.Data))) = uintptr(unsafe.Pointer(&samples[0]))
+	return e, nil
+}
+
+// [CloseHaptic] closes a haptic device previously opened with [OpenHaptic].
+//
+// [CloseHaptic]: https://wiki.libsdl.org/SDL3/SDL_CloseHaptic
+func CloseHaptic(haptic *Haptic) {
+	sdlCloseHaptic(haptic)
+}
+
+// [CreateHapticEffect] creates a new haptic effect on a device and returns its ID, or -1 on failure.
+//
+// [CreateHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_CreateHapticEffect
+func CreateHapticEffect(haptic *Haptic, effect *HapticEffect) int32 {
+	ret := sdlCreateHapticEffect(haptic, effect)
+	runtime.KeepAlive(effect)
+	return ret
+}
+
+// [DestroyHapticEffect] destroys a haptic effect on the device.
+//
+// [DestroyHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_DestroyHapticEffect
+func DestroyHapticEffect(haptic *Haptic, effect int32) {
+	sdlDestroyHapticEffect(haptic, effect)
+}
+
+// [GetHapticEffectStatus] gets the status of the current effect on a haptic device.
+//
+// The device must support [HapticStatus].
+//
+// [GetHapticEffectStatus]: https://wiki.libsdl.org/SDL3/SDL_GetHapticEffectStatus
+func GetHapticEffectStatus(haptic *Haptic, effect int32) bool {
+	return sdlGetHapticEffectStatus(haptic, effect)
+}
+
+// [GetHapticFeatures] gets the haptic device's supported features as a bitmask of [HapticEffectType]s
+// and flags like [HapticGain], or 0 on failure.
+//
+// [GetHapticFeatures]: https://wiki.libsdl.org/SDL3/SDL_GetHapticFeatures
+func GetHapticFeatures(haptic *Haptic) uint32 {
+	return sdlGetHapticFeatures(haptic)
+}
+
+// [GetHapticFromID] gets the [Haptic] associated with an instance ID, or nil if it isn't opened.
+//
+// [GetHapticFromID]: https://wiki.libsdl.org/SDL3/SDL_GetHapticFromID
+func GetHapticFromID(instanceId HapticID) *Haptic {
+	return sdlGetHapticFromID(instanceId)
+}
+
+// [GetHapticID] gets the instance ID of an opened haptic device, or 0 on failure.
+//
+// [GetHapticID]: https://wiki.libsdl.org/SDL3/SDL_GetHapticID
+func GetHapticID(haptic *Haptic) HapticID {
+	return sdlGetHapticID(haptic)
+}
+
+// [GetHapticName] gets the implementation dependent name of a haptic device.
+//
+// [GetHapticName]: https://wiki.libsdl.org/SDL3/SDL_GetHapticName
+func GetHapticName(haptic *Haptic) string {
+	return sdlGetHapticName(haptic)
+}
+
+// [GetHapticNameForID] gets the implementation dependent name of a haptic device.
+//
+// This can be called before any haptic devices are opened.
+//
+// [GetHapticNameForID]: https://wiki.libsdl.org/SDL3/SDL_GetHapticNameForID
+func GetHapticNameForID(instanceId HapticID) string {
+	return sdlGetHapticNameForID(instanceId)
+}
+
+// [GetHaptics] gets a list of currently connected haptic devices.
+//
+// [GetHaptics]: https://wiki.libsdl.org/SDL3/SDL_GetHaptics
+func GetHaptics() []HapticID {
+	var count int32
+	haptics := sdlGetHaptics(&count)
+	if haptics == nil {
+		return nil
+	}
+	defer Free(unsafe.Pointer(haptics))
+	return mem.Copy(haptics, count)
+}
+
+// [GetMaxHapticEffects] gets the number of effects a haptic device can store, or -1 on failure.
+//
+// [GetMaxHapticEffects]: https://wiki.libsdl.org/SDL3/SDL_GetMaxHapticEffects
+func GetMaxHapticEffects(haptic *Haptic) int32 {
+	return sdlGetMaxHapticEffects(haptic)
+}
+
+// [GetMaxHapticEffectsPlaying] gets the number of effects a haptic device can play at the same time, or -1 on failure.
+//
+// [GetMaxHapticEffectsPlaying]: https://wiki.libsdl.org/SDL3/SDL_GetMaxHapticEffectsPlaying
+func GetMaxHapticEffectsPlaying(haptic *Haptic) int32 {
+	return sdlGetMaxHapticEffectsPlaying(haptic)
+}
+
+// [GetNumHapticAxes] gets the number of haptic axes the device has, or -1 on failure.
+//
+// [GetNumHapticAxes]: https://wiki.libsdl.org/SDL3/SDL_GetNumHapticAxes
+func GetNumHapticAxes(haptic *Haptic) int32 {
+	return sdlGetNumHapticAxes(haptic)
+}
+
+// [HapticEffectSupported] checks to see if an effect is supported by a haptic device.
+//
+// [HapticEffectSupported]: https://wiki.libsdl.org/SDL3/SDL_HapticEffectSupported
+func HapticEffectSupported(haptic *Haptic, effect *HapticEffect) bool {
+	ret := sdlHapticEffectSupported(haptic, effect)
+	runtime.KeepAlive(effect)
+	return ret
+}
+
+// [HapticRumbleSupported] checks whether rumble is supported on a haptic device.
+//
+// [HapticRumbleSupported]: https://wiki.libsdl.org/SDL3/SDL_HapticRumbleSupported
+func HapticRumbleSupported(haptic *Haptic) bool {
+	return sdlHapticRumbleSupported(haptic)
+}
+
+// [InitHapticRumble] initializes a haptic device for simple rumble playback.
+//
+// [InitHapticRumble]: https://wiki.libsdl.org/SDL3/SDL_InitHapticRumble
+func InitHapticRumble(haptic *Haptic) bool {
+	return sdlInitHapticRumble(haptic)
+}
+
+// [IsJoystickHaptic] queries if a joystick has haptic features.
+//
+// [IsJoystickHaptic]: https://wiki.libsdl.org/SDL3/SDL_IsJoystickHaptic
+func IsJoystickHaptic(joystick *Joystick) bool {
+	return sdlIsJoystickHaptic(joystick)
+}
+
+// [IsMouseHaptic] queries whether or not the current mouse has haptic capabilities.
+//
+// [IsMouseHaptic]: https://wiki.libsdl.org/SDL3/SDL_IsMouseHaptic
+func IsMouseHaptic() bool {
+	return sdlIsMouseHaptic()
+}
+
+// [OpenHaptic] opens a haptic device for use.
+//
+// [OpenHaptic]: https://wiki.libsdl.org/SDL3/SDL_OpenHaptic
+func OpenHaptic(instanceId HapticID) *Haptic {
+	return sdlOpenHaptic(instanceId)
+}
+
+// [OpenHapticFromJoystick] opens a haptic device for use from a joystick device.
+//
+// [OpenHapticFromJoystick]: https://wiki.libsdl.org/SDL3/SDL_OpenHapticFromJoystick
+func OpenHapticFromJoystick(joystick *Joystick) *Haptic {
+	return sdlOpenHapticFromJoystick(joystick)
+}
+
+// [OpenHapticFromMouse] tries to open a haptic device from the current mouse.
+//
+// [OpenHapticFromMouse]: https://wiki.libsdl.org/SDL3/SDL_OpenHapticFromMouse
+func OpenHapticFromMouse() *Haptic {
+	return sdlOpenHapticFromMouse()
+}
+
+// [PauseHaptic] pauses a haptic device. The device must support [HapticPause].
+//
+// [PauseHaptic]: https://wiki.libsdl.org/SDL3/SDL_PauseHaptic
+func PauseHaptic(haptic *Haptic) bool {
+	return sdlPauseHaptic(haptic)
+}
+
+// [PlayHapticRumble] runs a simple rumble effect with a strength from 0 to 1 for length milliseconds.
+//
+// [PlayHapticRumble]: https://wiki.libsdl.org/SDL3/SDL_PlayHapticRumble
+func PlayHapticRumble(haptic *Haptic, strength float32, length uint32) bool {
+	return sdlPlayHapticRumble(haptic, strength, length)
+}
+
+// [ResumeHaptic] resumes a haptic device paused with [PauseHaptic].
+//
+// [ResumeHaptic]: https://wiki.libsdl.org/SDL3/SDL_ResumeHaptic
+func ResumeHaptic(haptic *Haptic) bool {
+	return sdlResumeHaptic(haptic)
+}
+
+// [RunHapticEffect] runs the haptic effect on its associated haptic device. Use [HapticInfinity] to repeat it forever.
+//
+// [RunHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_RunHapticEffect
+func RunHapticEffect(haptic *Haptic, effect int32, iterations uint32) bool {
+	return sdlRunHapticEffect(haptic, effect, iterations)
+}
+
+// [SetHapticAutocenter] sets the global autocenter of the device from 0 to 100. The device must support [HapticAutocenter].
+//
+// [SetHapticAutocenter]: https://wiki.libsdl.org/SDL3/SDL_SetHapticAutocenter
+func SetHapticAutocenter(haptic *Haptic, autocenter int32) bool {
+	return sdlSetHapticAutocenter(haptic, autocenter)
+}
+
+// [SetHapticGain] sets the global gain of the device from 0 to 100. The device must support [HapticGain].
+//
+// [SetHapticGain]: https://wiki.libsdl.org/SDL3/SDL_SetHapticGain
+func SetHapticGain(haptic *Haptic, gain int32) bool {
+	return sdlSetHapticGain(haptic, gain)
+}
+
+// [StopHapticEffect] stops the haptic effect on its associated haptic device.
+//
+// [StopHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_StopHapticEffect
+func StopHapticEffect(haptic *Haptic, effect int32) bool {
+	return sdlStopHapticEffect(haptic, effect)
+}
+
+// [StopHapticEffects] stops all the currently playing effects on a haptic device.
+//
+// [StopHapticEffects]: https://wiki.libsdl.org/SDL3/SDL_StopHapticEffects
+func StopHapticEffects(haptic *Haptic) bool {
+	return sdlStopHapticEffects(haptic)
+}
+
+// [StopHapticRumble] stops the simple rumble on a haptic device.
+//
+// [StopHapticRumble]: https://wiki.libsdl.org/SDL3/SDL_StopHapticRumble
+func StopHapticRumble(haptic *Haptic) bool {
+	return sdlStopHapticRumble(haptic)
+}
+
+// [UpdateHapticEffect] updates the properties of an effect. It can't change the type of the effect.
+//
+// [UpdateHapticEffect]: https://wiki.libsdl.org/SDL3/SDL_UpdateHapticEffect
+func UpdateHapticEffect(haptic *Haptic, effect int32, data *HapticEffect) bool {
+	ret := sdlUpdateHapticEffect(haptic, effect, data)
+	runtime.KeepAlive(data)
+	return ret
+}
@@ -0,0 +1,103 @@
+package sdl
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+// checkLayout compares the offsets of the named fields of a struct and its size with the C layout.
+func checkLayout(t *testing.T, value interface{}, size uintptr, offsets map[string]uintptr) {
+	t.Helper()
+	typ := reflect.TypeOf(value)
+	if typ.Size() != size {
+		t.Errorf("%s: size = %d, want %d", typ.Name(), typ.Size(), size)
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Name == "_" {
+			continue
+		}
+		want, ok := offsets[field.Name]
+		if !ok {
+			t.Errorf("%s.%s: no expected offset", typ.Name(), field.Name)
+			continue
+		}
+		if field.Offset != want {
+			t.Errorf("%s.%s: offset = %d, want %d", typ.Name(), field.Name, field.Offset, want)
+		}
+		delete(offsets, field.Name)
+	}
+	for name := range offsets {
+		t.Errorf("%s.%s: field is missing", typ.Name(), name)
+	}
+}
+
+func TestHapticLayout(t *testing.T) {
+	ptrSize := unsafe.Sizeof(uintptr(0))
+
+	checkLayout(t, HapticDirection{}, 16, map[string]uintptr{"Type": 0, "Dir": 4})
+	checkLayout(t, HapticConstant{}, 40, map[string]uintptr{
+		"Type": 0, "Direction": 4, "Length": 20, "Delay": 24, "Button": 26, "Interval": 28, "Level": 30,
+		"AttackLength": 32, "AttackLevel": 34, "FadeLength": 36, "FadeLevel": 38,
+	})
+	checkLayout(t, HapticPeriodic{}, 48, map[string]uintptr{
+		"Type": 0, "Direction": 4, "Length": 20, "Delay": 24, "Button": 26, "Interval": 28,
+		"Period": 30, "Magnitude": 32, "Offset": 34, "Phase": 36,
+		"AttackLength": 38, "AttackLevel": 40, "FadeLength": 42, "FadeLevel": 44,
+	})
+	checkLayout(t, HapticCondition{}, 68, map[string]uintptr{
+		"Type": 0, "Direction": 4, "Length": 20, "Delay": 24, "Button": 26, "Interval": 28,
+		"RightSat": 30, "LeftSat": 36, "RightCoeff": 42, "LeftCoeff": 48, "Deadband": 54, "Center": 60,
+	})
+	checkLayout(t, HapticRamp{}, 44, map[string]uintptr{
+		"Type": 0, "Direction": 4, "Length": 20, "Delay": 24, "Button": 26, "Interval": 28,
+		"Start": 30, "End": 32, "AttackLength": 34, "AttackLevel": 36, "FadeLength": 38, "FadeLevel": 40,
+	})
+	checkLayout(t, HapticLeftRight{}, 12, map[string]uintptr{
+		"Type": 0, "Length": 4, "LargeMagnitude": 8, "SmallMagnitude": 10,
+	})
+
+	// the pointer is 8 byte aligned on 64-bit platforms and follows Samples directly on 32-bit platforms
+	data := uintptr(40)
+	if ptrSize == 4 {
+		data = 36
+	}
+	checkLayout(t, HapticCustom{}, data+ptrSize+8, map[string]uintptr{
+		"Type": 0, "Direction": 4, "Length": 20, "Delay": 24, "Button": 26, "Interval": 28,
+		"Channels": 30, "Period": 32, "Samples": 34, "Data": data,
+		"AttackLength": data + ptrSize, "AttackLevel": data + ptrSize + 2, "FadeLength": data + ptrSize + 4, "FadeLevel": data + ptrSize + 6,
+	})
+
+	if ptrSize == 8 {
+		// the union is as large as its largest member, HapticCondition, rounded up to the pointer alignment
+		if size := unsafe.Sizeof(HapticEffect{}.raw); size != 72 {
+			t.Errorf("HapticEffect: union size = %d, want 72", size)
+		}
+	}
+}
+
+func TestCustomEffect(t *testing.T) {
+	samples := []uint16{1, 2, 3, 4, 5, 6}
+	effect, err := CustomEffect(HapticCustom{Channels: 2, Length: 100}, samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := effect.Custom()
+	if effect.Type() != HapticEffectCustom || custom.Samples != 3 || custom.Length != 100 {
+		t.Errorf("got type %#x, %d samples, length %d", effect.Type(), custom.Samples, custom.Length)
+	}
+	if custom.Data == nil {
+		t.Fatal("Data is nil")
+	}
+	got := unsafe.Slice(custom.Data, len(samples))
+	for i := range samples {
+		if got[i] != samples[i] {
+			t.Fatalf("Data = %v, want %v", got, samples)
+		}
+	}
+
+	if _, err := CustomEffect(HapticCustom{Channels: 4}, samples); err == nil {
+		t.Error("6 samples were split into 4 channels")
+	}
+}
@@ -1,5 +1,17 @@
 package sdl
 
+import (
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"time"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
+)
+
 type HidBusType uint32
 
 const (
@@ -10,90 +22,382 @@ const (
 	HidApiBusSPI
 )
 
-// func hid_ble_scan(active bool)  {
-//	sdlhid_ble_scan(active)
-// }
+// hidDevice is the opaque SDL_hid_device.
+type hidDevice struct{}
+
+// hidDeviceInfo is the C layout of SDL_hid_device_info.
+type hidDeviceInfo struct {
+	path               *byte
+	vendorID           uint16
+	productID          uint16
+	serialNumber       unsafe.Pointer
+	releaseNumber      uint16
+	manufacturerString unsafe.Pointer
+	productString      unsafe.Pointer
+	usagePage          uint16
+	usage              uint16
+	interfaceNumber    int32
+	interfaceClass     int32
+	interfaceSubclass  int32
+	interfaceProtocol  int32
+	busType            HidBusType
+	next               *hidDeviceInfo
+}
+
+// HidDeviceInfo describes a HID device found by [HidEnumerate].
+type HidDeviceInfo struct {
+	// Path is the platform-specific device path, which can be passed to [HidOpenPath].
+	Path         string
+	VendorID     uint16
+	ProductID    uint16
+	SerialNumber string
+	// ReleaseNumber is the device release number in binary-coded decimal, also known as device version number.
+	ReleaseNumber uint16
+	Manufacturer  string
+	Product       string
+	// UsagePage and Usage are only available on Windows, macOS and with the Linux hidraw backend.
+	UsagePage uint16
+	Usage     uint16
+	// InterfaceNumber is the USB interface of the device, or -1 if it isn't a USB HID device.
+	InterfaceNumber   int32
+	InterfaceClass    int32
+	InterfaceSubclass int32
+	InterfaceProtocol int32
+	BusType           HidBusType
+}
+
+func (info *hidDeviceInfo) toGo() HidDeviceInfo {
+	return HidDeviceInfo{
+		Path:              convert.ToString(info.path),
+		VendorID:          info.vendorID,
+		ProductID:         info.productID,
+		SerialNumber:      wcharToString(info.serialNumber),
+		ReleaseNumber:     info.releaseNumber,
+		Manufacturer:      wcharToString(info.manufacturerString),
+		Product:           wcharToString(info.productString),
+		UsagePage:         info.usagePage,
+		Usage:             info.usage,
+		InterfaceNumber:   info.interfaceNumber,
+		InterfaceClass:    info.interfaceClass,
+		InterfaceSubclass: info.interfaceSubclass,
+		InterfaceProtocol: info.interfaceProtocol,
+		BusType:           info.busType,
+	}
+}
+
+// wchar_t is UTF-16 on Windows and UTF-32 everywhere else.
+var wcharSize = func() uintptr {
+	if runtime.GOOS == "windows" {
+		return 2
+	}
+	return 4
+}()
+
+// hidStringLength is the buffer size in wide characters used to read device strings.
+const hidStringLength = 256
+
+// wcharToString converts a null terminated wide string to a Go string. p may be nil.
+func wcharToString(p unsafe.Pointer) string {
+	if p == nil {
+		return ""
+	}
+	if wcharSize == 2 {
+		var units []uint16
+		for ptr := p; *(*uint16)(ptr) != 0; ptr = unsafe.Add(ptr, 2) {
+			units = append(units, *(*uint16)(ptr))
+		}
+		return string(utf16.Decode(units))
+	}
+	var runes []rune
+	for ptr := p; *(*uint32)(ptr) != 0; ptr = unsafe.Add(ptr, 4) {
+		runes = append(runes, rune(*(*uint32)(ptr)))
+	}
+	return string(runes)
+}
+
+// stringToWchar converts a Go string to a null terminated wide string. It returns nil for an empty string.
+func stringToWchar(s string) unsafe.Pointer {
+	if s == "" {
+		return nil
+	}
+	if wcharSize == 2 {
+		units := append(utf16.Encode([]rune(s)), 0)
+		return unsafe.Pointer(&units[0])
+	}
+	runes := append([]rune(s), 0)
+	return unsafe.Pointer(&runes[0])
+}
+
+// hidError returns an error for a failed HID operation, including the SDL error message if there is one.
+func hidError(op string) error {
+	if msg := GetError(); msg != "" {
+		return fmt.Errorf("sdl: hid %s: %s", op, msg)
+	}
+	return fmt.Errorf("sdl: hid %s failed", op)
+}
+
+// [HidInit] initializes the HIDAPI library. It returns 0 on success and -1 on error.
+//
+// Calling it is optional, it is called automatically by [HidEnumerate] and the open functions.
+//
+// [HidInit]: https://wiki.libsdl.org/SDL3/SDL_hid_init
+func HidInit() int32 {
+	return sdlHidInit()
+}
+
+// [HidExit] finalizes the HIDAPI library. It returns 0 on success and -1 on error.
+//
+// [HidExit]: https://wiki.libsdl.org/SDL3/SDL_hid_exit
+func HidExit() int32 {
+	return sdlHidExit()
+}
+
+// [HidDeviceChangeCount] returns a counter that changes when devices are added or removed.
+// If it returns 0, change detection isn't available and you should call [HidEnumerate] periodically.
+//
+// [HidDeviceChangeCount]: https://wiki.libsdl.org/SDL3/SDL_hid_device_change_count
+func HidDeviceChangeCount() uint32 {
+	return sdlHidDeviceChangeCount()
+}
+
+// [HidBleScan] starts or stops a BLE scan on iOS and tvOS to pair Steam Controllers.
+//
+// [HidBleScan]: https://wiki.libsdl.org/SDL3/SDL_hid_ble_scan
+func HidBleScan(active bool) {
+	sdlHidBleScan(active)
+}
+
+// [HidEnumerate] lists the HID devices that match vendorID and productID. Use 0 for either to match any device.
+//
+// [HidEnumerate]: https://wiki.libsdl.org/SDL3/SDL_hid_enumerate
+func HidEnumerate(vendorID uint16, productID uint16) []HidDeviceInfo {
+	list := sdlHidEnumerate(vendorID, productID)
+	if list == nil {
+		return nil
+	}
+	defer sdlHidFreeEnumeration(list)
+
+	var infos []HidDeviceInfo
+	for info := list; info != nil; info = info.next {
+		infos = append(infos, info.toGo())
+	}
+	return infos
+}
 
-// func hid_close(dev *hid_device) int32 {
-//	return sdlhid_close(dev)
-// }
+// HidDevice is an opened HID device. It implements [io.ReadWriteCloser], where every
+// Read returns a single input report and every Write sends a single output report.
+//
+// A device must not be used from multiple goroutines at the same time.
+type HidDevice struct {
+	dev         *hidDevice
+	nonblocking bool
+	deadline    time.Time
+}
 
-// func hid_device_change_count() uint32 {
-//	return sdlhid_device_change_count()
-// }
+// [HidOpen] opens a HID device by its vendor ID, product ID and optionally serial number.
+// If serialNumber is empty, the first device with the given IDs is opened.
+//
+// [HidOpen]: https://wiki.libsdl.org/SDL3/SDL_hid_open
+func HidOpen(vendorID uint16, productID uint16, serialNumber string) (*HidDevice, error) {
+	serial := stringToWchar(serialNumber)
+	dev := sdlHidOpen(vendorID, productID, serial)
+	runtime.KeepAlive(serial)
+	if dev == nil {
+		return nil, hidError("open")
+	}
+	return &HidDevice{dev: dev}, nil
+}
 
-// func hid_enumerate(vendor_id uint16, product_id uint16) *hid_device_info {
-//	return sdlhid_enumerate(vendor_id, product_id)
-// }
+// [HidOpenPath] opens a HID device by its path name, see [HidDeviceInfo.Path].
+//
+// [HidOpenPath]: https://wiki.libsdl.org/SDL3/SDL_hid_open_path
+func HidOpenPath(path string) (*HidDevice, error) {
+	dev := sdlHidOpenPath(path)
+	if dev == nil {
+		return nil, hidError("open")
+	}
+	return &HidDevice{dev: dev}, nil
+}
 
-// func hid_exit() int32 {
-//	return sdlhid_exit()
-// }
+// Close closes the device. Closing an already closed device returns [os.ErrClosed].
+func (d *HidDevice) Close() error {
+	if d.dev == nil {
+		return os.ErrClosed
+	}
+	ret := sdlHidClose(d.dev)
+	d.dev = nil
+	if ret != 0 {
+		return hidError("close")
+	}
+	return nil
+}
 
-// func hid_free_enumeration(devs *hid_device_info)  {
-//	sdlhid_free_enumeration(devs)
-// }
+// Read reads an input report into p. For devices with multiple reports, the first byte is the report ID.
+//
+// Read blocks until a report arrives, unless the device is in nonblocking mode or a read deadline is set.
+// If the deadline passes, Read returns an error wrapping [os.ErrDeadlineExceeded].
+// In nonblocking mode Read returns 0 and no error if no report is waiting.
+func (d *HidDevice) Read(p []byte) (int, error) {
+	if d.deadline.IsZero() || d.nonblocking {
+		return d.read(p, -1)
+	}
+	timeout := time.Until(d.deadline)
+	if timeout <= 0 {
+		return 0, fmt.Errorf("sdl: hid read: %w", os.ErrDeadlineExceeded)
+	}
+	return d.ReadTimeout(p, timeout)
+}
 
-// func hid_get_device_info(dev *hid_device) *hid_device_info {
-//	return sdlhid_get_device_info(dev)
-// }
+// ReadTimeout reads an input report into p, waiting at most timeout for it to arrive.
+// A negative timeout blocks until a report arrives.
+func (d *HidDevice) ReadTimeout(p []byte, timeout time.Duration) (int, error) {
+	ms := int32(-1)
+	if timeout >= math.MaxInt32*time.Millisecond {
+		ms = math.MaxInt32
+	} else if timeout >= 0 {
+		// round up, so that a short timeout doesn't turn into a non-blocking read
+		ms = int32((timeout + time.Millisecond - 1) / time.Millisecond)
+	}
+	n, err := d.read(p, ms)
+	if err == nil && n == 0 && ms >= 0 {
+		return 0, fmt.Errorf("sdl: hid read: %w", os.ErrDeadlineExceeded)
+	}
+	return n, err
+}
 
-// func hid_get_feature_report(dev *hid_device, data *uint8, length uint64) int32 {
-//	return sdlhid_get_feature_report(dev, data, length)
-// }
+func (d *HidDevice) read(p []byte, ms int32) (int, error) {
+	if d.dev == nil {
+		return 0, os.ErrClosed
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var ret int32
+	if ms < 0 {
+		ret = sdlHidRead(d.dev, &p[0], uint64(len(p)))
+	} else {
+		ret = sdlHidReadTimeout(d.dev, &p[0], uint64(len(p)), ms)
+	}
+	if ret < 0 {
+		return 0, hidError("read")
+	}
+	return int(ret), nil
+}
 
-// func hid_get_indexed_string(dev *hid_device, string_index int32, string *wchar_t, maxlen uint64) int32 {
-//	return sdlhid_get_indexed_string(dev, string_index, string, maxlen)
-// }
+// SetReadDeadline sets the time after which a blocking [HidDevice.Read] gives up. A zero time disables the deadline.
+func (d *HidDevice) SetReadDeadline(t time.Time) error {
+	if d.dev == nil {
+		return os.ErrClosed
+	}
+	d.deadline = t
+	return nil
+}
 
-// func hid_get_input_report(dev *hid_device, data *uint8, length uint64) int32 {
-//	return sdlhid_get_input_report(dev, data, length)
-// }
+// SetNonblocking switches the device between blocking and nonblocking reads.
+func (d *HidDevice) SetNonblocking(nonblocking bool) error {
+	if d.dev == nil {
+		return os.ErrClosed
+	}
+	var nonblock int32
+	if nonblocking {
+		nonblock = 1
+	}
+	if sdlHidSetNonblocking(d.dev, nonblock) != 0 {
+		return hidError("set nonblocking")
+	}
+	d.nonblocking = nonblocking
+	return nil
+}
 
-// func hid_get_manufacturer_string(dev *hid_device, string *wchar_t, maxlen uint64) int32 {
-//	return sdlhid_get_manufacturer_string(dev, string, maxlen)
-// }
+// Write sends an output report. The first byte must be the report ID, or 0 for devices that only support a single report.
+func (d *HidDevice) Write(p []byte) (int, error) {
+	return d.transfer("write", sdlHidWrite, p)
+}
 
-// func hid_get_product_string(dev *hid_device, string *wchar_t, maxlen uint64) int32 {
-//	return sdlhid_get_product_string(dev, string, maxlen)
-// }
+// GetFeatureReport reads a feature report into p. Set p[0] to the ID of the report to read.
+// The returned count includes the report ID byte.
+func (d *HidDevice) GetFeatureReport(p []byte) (int, error) {
+	return d.transfer("get feature report", sdlHidGetFeatureReport, p)
+}
 
-// func hid_get_report_descriptor(dev *hid_device, buf *uint8, buf_size uint64) int32 {
-//	return sdlhid_get_report_descriptor(dev, buf, buf_size)
-// }
+// SendFeatureReport sends a feature report. The first byte must be the report ID, or 0 for devices that only support a single report.
+func (d *HidDevice) SendFeatureReport(p []byte) (int, error) {
+	return d.transfer("send feature report", sdlHidSendFeatureReport, p)
+}
 
-// func hid_get_serial_number_string(dev *hid_device, string *wchar_t, maxlen uint64) int32 {
-//	return sdlhid_get_serial_number_string(dev, string, maxlen)
-// }
+// GetInputReport reads an input report through the control channel into p. Set p[0] to the ID of the report to read.
+func (d *HidDevice) GetInputReport(p []byte) (int, error) {
+	return d.transfer("get input report", sdlHidGetInputReport, p)
+}
 
-// func hid_init() int32 {
-//	return sdlhid_init()
-// }
+// ReportDescriptor returns the report descriptor of the device.
+func (d *HidDevice) ReportDescriptor() ([]byte, error) {
+	// 4096 is the maximum size of a HID report descriptor
+	buf := make([]byte, 4096)
+	n, err := d.transfer("get report descriptor", sdlHidGetReportDescriptor, buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
 
-// func hid_open(vendor_id uint16, product_id uint16, serial_number *wchar_t) *hid_device {
-//	return sdlhid_open(vendor_id, product_id, serial_number)
-// }
+func (d *HidDevice) transfer(op string, fn func(*hidDevice, *uint8, uint64) int32, p []byte) (int, error) {
+	if d.dev == nil {
+		return 0, os.ErrClosed
+	}
+	if len(p) == 0 {
+		return 0, fmt.Errorf("sdl: hid %s: empty buffer", op)
+	}
+	ret := fn(d.dev, &p[0], uint64(len(p)))
+	if ret < 0 {
+		return 0, hidError(op)
+	}
+	return int(ret), nil
+}
 
-// func hid_open_path(path string) *hid_device {
-//	return sdlhid_open_path(path)
-// }
+// Manufacturer returns the manufacturer string of the device.
+func (d *HidDevice) Manufacturer() (string, error) {
+	return d.str("get manufacturer string", sdlHidGetManufacturerString)
+}
 
-// func hid_read(dev *hid_device, data *uint8, length uint64) int32 {
-//	return sdlhid_read(dev, data, length)
-// }
+// Product returns the product string of the device.
+func (d *HidDevice) Product() (string, error) {
+	return d.str("get product string", sdlHidGetProductString)
+}
 
-// func hid_read_timeout(dev *hid_device, data *uint8, length uint64, milliseconds int32) int32 {
-//	return sdlhid_read_timeout(dev, data, length, milliseconds)
-// }
+// SerialNumber returns the serial number of the device.
+func (d *HidDevice) SerialNumber() (string, error) {
+	return d.str("get serial number string", sdlHidGetSerialNumberString)
+}
 
-// func hid_send_feature_report(dev *hid_device, data *uint8, length uint64) int32 {
-//	return sdlhid_send_feature_report(dev, data, length)
-// }
+// IndexedString returns the string descriptor with the given index.
+func (d *HidDevice) IndexedString(index int32) (string, error) {
+	return d.str("get indexed string", func(dev *hidDevice, buf unsafe.Pointer, maxlen uint64) int32 {
+		return sdlHidGetIndexedString(dev, index, buf, maxlen)
+	})
+}
 
-// func hid_set_nonblocking(dev *hid_device, nonblock int32) int32 {
-//	return sdlhid_set_nonblocking(dev, nonblock)
-// }
+func (d *HidDevice) str(op string, fn func(*hidDevice, unsafe.Pointer, uint64) int32) (string, error) {
+	if d.dev == nil {
+		return "", os.ErrClosed
+	}
+	// allocate as uint32, so that the buffer is correctly aligned for both wchar_t sizes
+	buf := make([]uint32, hidStringLength*wcharSize/4)
+	if fn(d.dev, unsafe.Pointer(&buf[0]), hidStringLength) < 0 {
+		return "", hidError(op)
+	}
+	return wcharToString(unsafe.Pointer(&buf[0])), nil
+}
 
-// func hid_write(dev *hid_device, data *uint8, length uint64) int32 {
-//	return sdlhid_write(dev, data, length)
-// }
+// Info returns the information about the device, like [HidEnumerate] does.
+func (d *HidDevice) Info() (HidDeviceInfo, error) {
+	if d.dev == nil {
+		return HidDeviceInfo{}, os.ErrClosed
+	}
+	// the returned info is owned by the device
+	info := sdlHidGetDeviceInfo(d.dev)
+	if info == nil {
+		return HidDeviceInfo{}, hidError("get device info")
+	}
+	return info.toGo(), nil
+}
@@ -1,6 +1,11 @@
 package sdl
 
-import "unsafe"
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
 
 type IOStatus uint32
 
@@ -23,6 +28,38 @@ const (
 
 type IOStream struct{}
 
+const (
+	PropIOStreamWindowsHandlePointer   = "SDL.iostream.windows.handle"
+	PropIOStreamStdioFilePointer       = "SDL.iostream.stdio.file"
+	PropIOStreamFileDescriptorNumber   = "SDL.iostream.file_descriptor"
+	PropIOStreamAndroidAassetPointer   = "SDL.iostream.android.aasset"
+	PropIOStreamMemoryPointer          = "SDL.iostream.memory.base"
+	PropIOStreamMemorySizeNumber       = "SDL.iostream.memory.size"
+	PropIOStreamDynamicMemoryPointer   = "SDL.iostream.dynamic.memory"
+	PropIOStreamDynamicChunksizeNumber = "SDL.iostream.dynamic.chunksize"
+)
+
+// GetDynamicMemBytes returns a copy of the data written to a stream created with [IOFromDynamicMem].
+// The memory of the stream stays owned by SDL and is freed by [CloseIO], so the stream can still be used afterwards.
+// It returns nil if nothing was written yet or the stream isn't a dynamic memory stream.
+//
+// Example:
+//
+//	stream := sdl.IOFromDynamicMem()
+//	defer sdl.CloseIO(stream)
+//	sdl.SaveBMPIO(surface, stream, false)
+//	data := sdl.GetDynamicMemBytes(stream)
+func GetDynamicMemBytes(stream *IOStream) []byte {
+	ptr := GetPointerProperty(GetIOProperties(stream), PropIOStreamDynamicMemoryPointer, nil)
+	size := GetIOSize(stream)
+	if ptr == nil || size <= 0 {
+		return nil
+	}
+	data := make([]byte, size)
+	copy(data, unsafe.Slice((*byte)(ptr), size))
+	return data
+}
+
 // IOFromConstMem returns a read-only memory buffer for use with [IOStream] or nil on failure.
 func IOFromConstMem(mem []byte) *IOStream {
 	return sdlIOFromConstMem(mem, len(mem))
@@ -33,25 +70,42 @@ func CloseIO(context *IOStream) bool {
 	return sdlCloseIO(context)
 }
 
-// func FlushIO(context *IOStream) bool {
-//	return sdlFlushIO(context)
-// }
+// [FlushIO] flushes any buffered data in the stream.
+//
+// [FlushIO]: https://wiki.libsdl.org/SDL3/SDL_FlushIO
+func FlushIO(context *IOStream) bool {
+	return sdlFlushIO(context)
+}
 
-// func GetIOProperties(context *IOStream) PropertiesID {
-//	return sdlGetIOProperties(context)
-// }
+// [GetIOProperties] gets the properties associated with a stream, see the PropIOStream constants.
+//
+// [GetIOProperties]: https://wiki.libsdl.org/SDL3/SDL_GetIOProperties
+func GetIOProperties(context *IOStream) PropertiesID {
+	return sdlGetIOProperties(context)
+}
 
-// func GetIOSize(context *IOStream) int64 {
-//	return sdlGetIOSize(context)
-// }
+// [GetIOSize] gets the size of the data stream, or a negative value if unknown.
+//
+// [GetIOSize]: https://wiki.libsdl.org/SDL3/SDL_GetIOSize
+func GetIOSize(context *IOStream) int64 {
+	return sdlGetIOSize(context)
+}
 
-// func GetIOStatus(context *IOStream) IOStatus {
-//	return sdlGetIOStatus(context)
-// }
+// [GetIOStatus] queries the stream status, which is only updated by failed reads and writes.
+//
+// [GetIOStatus]: https://wiki.libsdl.org/SDL3/SDL_GetIOStatus
+func GetIOStatus(context *IOStream) IOStatus {
+	return sdlGetIOStatus(context)
+}
 
-// func IOFromDynamicMem() *IOStream {
-//	return sdlIOFromDynamicMem()
-// }
+// [IOFromDynamicMem] creates a stream that is backed by dynamically allocated memory, which grows as data is written.
+//
+// Use [GetDynamicMemBytes] to get the written data.
+//
+// [IOFromDynamicMem]: https://wiki.libsdl.org/SDL3/SDL_IOFromDynamicMem
+func IOFromDynamicMem() *IOStream {
+	return sdlIOFromDynamicMem()
+}
 
 // IOFromFile returns an [IOStream] for the named file. The mode can be "r" for read-only.
 func IOFromFile(file string, mode string) *IOStream {
@@ -70,21 +124,176 @@ func IOFromMem(mem []byte) *IOStream {
 //	return sdlIOvprintf(context, fmt, ap)
 // }
 
+// [LoadFile] loads all the data from a file path. The returned memory has to be freed with [Free],
+// see [ReadFile] for a version that returns a Go slice.
+//
+// [LoadFile]: https://wiki.libsdl.org/SDL3/SDL_LoadFile
 func LoadFile(file string, dataSize *uint64) unsafe.Pointer {
 	return sdlLoadFile(file, dataSize)
 }
 
-// func LoadFile_IO(src *IOStream, datasize *uint64, closeio bool) unsafe.Pointer {
-//	return sdlLoadFile_IO(src, datasize, closeio)
-// }
+// [LoadFileIO] loads all the data from a stream. The returned memory has to be freed with [Free],
+// see [ReadFileIO] for a version that returns a Go slice.
+//
+// [LoadFileIO]: https://wiki.libsdl.org/SDL3/SDL_LoadFile_IO
+func LoadFileIO(src *IOStream, dataSize *uint64, closeio bool) unsafe.Pointer {
+	return sdlLoadFile_IO(src, dataSize, closeio)
+}
 
-// func OpenIO(iface *IOStreamInterface, userdata unsafe.Pointer) *IOStream {
-//	return sdlOpenIO(iface, userdata)
-// }
+// IOStreamInterface holds the functions of a custom stream created with [OpenIO].
+// Functions that are nil are reported to SDL as unsupported.
+type IOStreamInterface struct {
+	// Size returns the total size of the data in bytes, or -1 if unknown.
+	Size func() int64
+	// Seek moves the read/write position and returns the new position, or -1 on failure.
+	Seek func(offset int64, whence IOWhence) int64
+	// Read reads up to len(p) bytes. If fewer bytes were read, the status explains why, like [IOStatusEof].
+	Read func(p []byte) (int, IOStatus)
+	// Write writes up to len(p) bytes. If fewer bytes were written, the status explains why.
+	Write func(p []byte) (int, IOStatus)
+	// Flush writes buffered data and returns [IOStatusReady] on success.
+	Flush func() IOStatus
+	// Close is called once when the stream is closed and reports whether that succeeded.
+	Close func() bool
+}
 
-// func ReadIO(context *IOStream, ptr unsafe.Pointer, size uint64) uint64 {
-//	return sdlReadIO(context, ptr, size)
-// }
+// ioStreamInterface is the C layout of SDL_IOStreamInterface.
+type ioStreamInterface struct {
+	version uint32
+	size    uintptr
+	seek    uintptr
+	read    uintptr
+	write   uintptr
+	flush   uintptr
+	close   uintptr
+}
+
+// ioStreams maps the userdata passed to SDL to the Go interface, like virtual joysticks do.
+var ioStreams = struct {
+	sync.Mutex
+	next   uintptr
+	ifaces map[uintptr]*IOStreamInterface
+}{ifaces: make(map[uintptr]*IOStreamInterface)}
+
+func lookupIOStream(userdata uintptr) *IOStreamInterface {
+	ioStreams.Lock()
+	defer ioStreams.Unlock()
+	return ioStreams.ifaces[userdata]
+}
+
+var ioStreamCallbacks struct {
+	once                                  sync.Once
+	size, seek, read, write, flush, close uintptr
+}
+
+func initIOStreamCallbacks() {
+	cb := &ioStreamCallbacks
+	cb.size = purego.NewCallback(func(userdata uintptr) int64 {
+		if iface := lookupIOStream(userdata); iface != nil && iface.Size != nil {
+			return iface.Size()
+		}
+		return -1
+	})
+	cb.seek = purego.NewCallback(func(userdata uintptr, offset int64, whence IOWhence) int64 {
+		if iface := lookupIOStream(userdata); iface != nil && iface.Seek != nil {
+			return iface.Seek(offset, whence)
+		}
+		SetError("%s", "seeking is not supported")
+		return -1
+	})
+	cb.read = purego.NewCallback(func(userdata uintptr, ptr *byte, size uintptr, status *IOStatus) uintptr {
+		iface := lookupIOStream(userdata)
+		if iface == nil || iface.Read == nil {
+			*status = IOStatusWriteOnly
+			return 0
+		}
+		n, st := iface.Read(unsafe.Slice(ptr, size))
+		if st != IOStatusReady {
+			*status = st
+		}
+		return uintptr(n)
+	})
+	cb.write = purego.NewCallback(func(userdata uintptr, ptr *byte, size uintptr, status *IOStatus) uintptr {
+		iface := lookupIOStream(userdata)
+		if iface == nil || iface.Write == nil {
+			*status = IOStatusReadOnly
+			return 0
+		}
+		n, st := iface.Write(unsafe.Slice(ptr, size))
+		if st != IOStatusReady {
+			*status = st
+		}
+		return uintptr(n)
+	})
+	cb.flush = purego.NewCallback(func(userdata uintptr, status *IOStatus) uintptr {
+		iface := lookupIOStream(userdata)
+		if iface == nil || iface.Flush == nil {
+			return 1
+		}
+		if st := iface.Flush(); st != IOStatusReady {
+			*status = st
+			return 0
+		}
+		return 1
+	})
+	cb.close = purego.NewCallback(func(userdata uintptr) uintptr {
+		ioStreams.Lock()
+		iface := ioStreams.ifaces[userdata]
+		delete(ioStreams.ifaces, userdata)
+		ioStreams.Unlock()
+		if iface == nil || iface.Close == nil {
+			return 1
+		}
+		return boolToUintptr(iface.Close())
+	})
+}
+
+// [OpenIO] creates a custom [IOStream] that calls the Go functions of iface.
+//
+// The stream must be closed with [CloseIO], which calls iface.Close. Every stream needs a little bookkeeping,
+// but the underlying callbacks are shared, so there is no limit on the number of streams.
+//
+// [OpenIO]: https://wiki.libsdl.org/SDL3/SDL_OpenIO
+func OpenIO(iface IOStreamInterface) *IOStream {
+	callbacks := &ioStreamCallbacks
+	callbacks.once.Do(initIOStreamCallbacks)
+
+	ioStreams.Lock()
+	ioStreams.next++
+	userdata := ioStreams.next
+	ioStreams.ifaces[userdata] = &iface
+	ioStreams.Unlock()
+
+	// SDL copies the interface, so it doesn't need to outlive the call
+	cIface := ioStreamInterface{
+		size:  callbacks.size,
+		seek:  callbacks.seek,
+		read:  callbacks.read,
+		write: callbacks.write,
+		flush: callbacks.flush,
+		close: callbacks.close,
+	}
+	cIface.version = uint32(unsafe.Sizeof(cIface))
+	stream := sdlOpenIO(&cIface, userdata)
+	if stream == nil {
+		ioStreams.Lock()
+		delete(ioStreams.ifaces, userdata)
+		ioStreams.Unlock()
+	}
+	return stream
+}
+
+// [ReadIO] reads up to len(buf) bytes from the stream and returns the number of bytes read.
+//
+// If fewer bytes were read, [GetIOStatus] tells whether the end of the data was reached or an error occurred.
+//
+// [ReadIO]: https://wiki.libsdl.org/SDL3/SDL_ReadIO
+func ReadIO(context *IOStream, buf []byte) uint64 {
+	if len(buf) == 0 {
+		return 0
+	}
+	return sdlReadIO(context, unsafe.Pointer(&buf[0]), uint64(len(buf)))
+}
 
 // func ReadS16BE(src *IOStream, value *int16) bool {
 //	return sdlReadS16BE(src, value)
@@ -142,25 +351,45 @@ func LoadFile(file string, dataSize *uint64) unsafe.Pointer {
 //	return sdlReadU8(src, value)
 // }
 
-// func SaveFile(file string, data unsafe.Pointer, datasize uint64) bool {
-//	return sdlSaveFile(file, data, datasize)
-// }
+// [SaveFile] saves all the data into a file path. See [WriteFile] for a version that takes a Go slice.
+//
+// [SaveFile]: https://wiki.libsdl.org/SDL3/SDL_SaveFile
+func SaveFile(file string, data unsafe.Pointer, dataSize uint64) bool {
+	return sdlSaveFile(file, data, dataSize)
+}
 
-// func SaveFile_IO(src *IOStream, data unsafe.Pointer, datasize uint64, closeio bool) bool {
-//	return sdlSaveFile_IO(src, data, datasize, closeio)
-// }
+// [SaveFileIO] saves all the data into a stream. See [WriteFileIO] for a version that takes a Go slice.
+//
+// [SaveFileIO]: https://wiki.libsdl.org/SDL3/SDL_SaveFile_IO
+func SaveFileIO(src *IOStream, data unsafe.Pointer, dataSize uint64, closeio bool) bool {
+	return sdlSaveFile_IO(src, data, dataSize, closeio)
+}
 
-// func SeekIO(context *IOStream, offset int64, whence IOWhence) int64 {
-//	return sdlSeekIO(context, offset, whence)
-// }
+// [SeekIO] seeks within the stream and returns the new position, or -1 on failure.
+//
+// [SeekIO]: https://wiki.libsdl.org/SDL3/SDL_SeekIO
+func SeekIO(context *IOStream, offset int64, whence IOWhence) int64 {
+	return sdlSeekIO(context, offset, whence)
+}
 
-// func TellIO(context *IOStream) int64 {
-//	return sdlTellIO(context)
-// }
+// [TellIO] returns the current read/write position of the stream, or -1 if it can't be determined.
+//
+// [TellIO]: https://wiki.libsdl.org/SDL3/SDL_TellIO
+func TellIO(context *IOStream) int64 {
+	return sdlTellIO(context)
+}
 
-// func WriteIO(context *IOStream, ptr unsafe.Pointer, size uint64) uint64 {
-//	return sdlWriteIO(context, ptr, size)
-// }
+// [WriteIO] writes buf to the stream and returns the number of bytes written.
+//
+// If fewer bytes were written, [GetIOStatus] tells why.
+//
+// [WriteIO]: https://wiki.libsdl.org/SDL3/SDL_WriteIO
+func WriteIO(context *IOStream, buf []byte) uint64 {
+	if len(buf) == 0 {
+		return 0
+	}
+	return sdlWriteIO(context, unsafe.Pointer(&buf[0]), uint64(len(buf)))
+}
 
 // func WriteS16BE(dst *IOStream, value int16) bool {
 //	return sdlWriteS16BE(dst, value)
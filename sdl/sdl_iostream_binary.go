@@ -0,0 +1,224 @@
+package sdl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// BinaryReader reads fixed-size values from an [IOStream] in a given byte order.
+//
+// A read that hits the end of the stream before any byte was read returns [io.EOF],
+// a value that is cut off returns [io.ErrUnexpectedEOF].
+//
+// Example:
+//
+//	r := sdl.NewBinaryReader(stream, binary.LittleEndian)
+//	magic, err := r.ReadU32()
+//	var header AssetHeader
+//	err = r.ReadStruct(&header)
+type BinaryReader struct {
+	// Order can be changed between reads, e.g. after reading a byte order mark.
+	Order binary.ByteOrder
+	io    IO
+	buf   [8]byte
+}
+
+// NewBinaryReader creates a reader for the stream. The stream is not closed by the reader.
+func NewBinaryReader(stream *IOStream, order binary.ByteOrder) *BinaryReader {
+	return &BinaryReader{Order: order, io: IO{stream: stream}}
+}
+
+func (r *BinaryReader) read(n int) ([]byte, error) {
+	if _, err := io.ReadFull(&r.io, r.buf[:n]); err != nil {
+		return nil, err
+	}
+	return r.buf[:n], nil
+}
+
+// Read implements [io.Reader], so that the reader can be passed on to other decoders.
+func (r *BinaryReader) Read(p []byte) (int, error) {
+	return r.io.Read(p)
+}
+
+// ReadU8 reads an unsigned 8-bit integer.
+func (r *BinaryReader) ReadU8() (uint8, error) {
+	b, err := r.read(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// ReadS8 reads a signed 8-bit integer.
+func (r *BinaryReader) ReadS8() (int8, error) {
+	v, err := r.ReadU8()
+	return int8(v), err
+}
+
+// ReadU16 reads an unsigned 16-bit integer in the reader's byte order.
+func (r *BinaryReader) ReadU16() (uint16, error) {
+	b, err := r.read(2)
+	if err != nil {
+		return 0, err
+	}
+	return r.Order.Uint16(b), nil
+}
+
+// ReadS16 reads a signed 16-bit integer in the reader's byte order.
+func (r *BinaryReader) ReadS16() (int16, error) {
+	v, err := r.ReadU16()
+	return int16(v), err
+}
+
+// ReadU32 reads an unsigned 32-bit integer in the reader's byte order.
+func (r *BinaryReader) ReadU32() (uint32, error) {
+	b, err := r.read(4)
+	if err != nil {
+		return 0, err
+	}
+	return r.Order.Uint32(b), nil
+}
+
+// ReadS32 reads a signed 32-bit integer in the reader's byte order.
+func (r *BinaryReader) ReadS32() (int32, error) {
+	v, err := r.ReadU32()
+	return int32(v), err
+}
+
+// ReadU64 reads an unsigned 64-bit integer in the reader's byte order.
+func (r *BinaryReader) ReadU64() (uint64, error) {
+	b, err := r.read(8)
+	if err != nil {
+		return 0, err
+	}
+	return r.Order.Uint64(b), nil
+}
+
+// ReadS64 reads a signed 64-bit integer in the reader's byte order.
+func (r *BinaryReader) ReadS64() (int64, error) {
+	v, err := r.ReadU64()
+	return int64(v), err
+}
+
+// ReadF32 reads a 32-bit floating-point number in the reader's byte order.
+func (r *BinaryReader) ReadF32() (float32, error) {
+	v, err := r.ReadU32()
+	return math.Float32frombits(v), err
+}
+
+// ReadF64 reads a 64-bit floating-point number in the reader's byte order.
+func (r *BinaryReader) ReadF64() (float64, error) {
+	v, err := r.ReadU64()
+	return math.Float64frombits(v), err
+}
+
+// ReadBytes reads exactly n bytes.
+func (r *BinaryReader) ReadBytes(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("sdl: negative byte count %d", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(&r.io, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// ReadStruct reads a fixed-size value, a pointer to a struct or a slice of fixed-size values as [binary.Read] does.
+func (r *BinaryReader) ReadStruct(v any) error {
+	return binary.Read(&r.io, r.Order, v)
+}
+
+// BinaryWriter writes fixed-size values to an [IOStream] in a given byte order.
+type BinaryWriter struct {
+	// Order can be changed between writes.
+	Order binary.ByteOrder
+	io    IO
+	buf   [8]byte
+}
+
+// NewBinaryWriter creates a writer for the stream. The stream is not closed by the writer.
+func NewBinaryWriter(stream *IOStream, order binary.ByteOrder) *BinaryWriter {
+	return &BinaryWriter{Order: order, io: IO{stream: stream}}
+}
+
+func (w *BinaryWriter) write(b []byte) error {
+	_, err := w.io.Write(b)
+	return err
+}
+
+// Write implements [io.Writer], so that the writer can be passed on to other encoders.
+func (w *BinaryWriter) Write(p []byte) (int, error) {
+	return w.io.Write(p)
+}
+
+// WriteU8 writes an unsigned 8-bit integer.
+func (w *BinaryWriter) WriteU8(v uint8) error {
+	w.buf[0] = v
+	return w.write(w.buf[:1])
+}
+
+// WriteS8 writes a signed 8-bit integer.
+func (w *BinaryWriter) WriteS8(v int8) error {
+	return w.WriteU8(uint8(v))
+}
+
+// WriteU16 writes an unsigned 16-bit integer in the writer's byte order.
+func (w *BinaryWriter) WriteU16(v uint16) error {
+	w.Order.PutUint16(w.buf[:2], v)
+	return w.write(w.buf[:2])
+}
+
+// WriteS16 writes a signed 16-bit integer in the writer's byte order.
+func (w *BinaryWriter) WriteS16(v int16) error {
+	return w.WriteU16(uint16(v))
+}
+
+// WriteU32 writes an unsigned 32-bit integer in the writer's byte order.
+func (w *BinaryWriter) WriteU32(v uint32) error {
+	w.Order.PutUint32(w.buf[:4], v)
+	return w.write(w.buf[:4])
+}
+
+// WriteS32 writes a signed 32-bit integer in the writer's byte order.
+func (w *BinaryWriter) WriteS32(v int32) error {
+	return w.WriteU32(uint32(v))
+}
+
+// WriteU64 writes an unsigned 64-bit integer in the writer's byte order.
+func (w *BinaryWriter) WriteU64(v uint64) error {
+	w.Order.PutUint64(w.buf[:8], v)
+	return w.write(w.buf[:8])
+}
+
+// WriteS64 writes a signed 64-bit integer in the writer's byte order.
+func (w *BinaryWriter) WriteS64(v int64) error {
+	return w.WriteU64(uint64(v))
+}
+
+// WriteF32 writes a 32-bit floating-point number in the writer's byte order.
+func (w *BinaryWriter) WriteF32(v float32) error {
+	return w.WriteU32(math.Float32bits(v))
+}
+
+// WriteF64 writes a 64-bit floating-point number in the writer's byte order.
+func (w *BinaryWriter) WriteF64(v float64) error {
+	return w.WriteU64(math.Float64bits(v))
+}
+
+// WriteBytes writes b as is.
+func (w *BinaryWriter) WriteBytes(b []byte) error {
+	return w.write(b)
+}
+
+// WriteStruct writes a fixed-size value, a pointer to a struct or a slice of fixed-size values as [binary.Write] does.
+func (w *BinaryWriter) WriteStruct(v any) error {
+	return binary.Write(&w.io, w.Order, v)
+}
+
+// Flush flushes the underlying stream.
+func (w *BinaryWriter) Flush() error {
+	return w.io.Flush()
+}
@@ -0,0 +1,98 @@
+package sdl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"testing"
+)
+
+type binaryHeader struct {
+	Magic   [4]byte
+	Version uint16
+	Flags   int16
+	Count   uint32
+	Scale   float64
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		data := make([]byte, 128)
+		f := memIO(t, data, false)
+
+		w := NewBinaryWriter(f.stream, order)
+		header := binaryHeader{Magic: [4]byte{'T', 'E', 'S', 'T'}, Version: 3, Flags: -2, Count: 70000, Scale: 0.25}
+		for _, err := range []error{
+			w.WriteU8(0xAB), w.WriteS8(-5),
+			w.WriteU16(0x1234), w.WriteS16(-300),
+			w.WriteU32(0xDEADBEEF), w.WriteS32(-70000),
+			w.WriteU64(0x0102030405060708), w.WriteS64(math.MinInt64),
+			w.WriteF32(1.5), w.WriteF64(-math.Pi),
+			w.WriteStruct(&header), w.WriteBytes([]byte("end")),
+		} {
+			if err != nil {
+				t.Fatalf("%v: write: %v", order, err)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		// the 16-bit value starts at offset 2
+		want := []byte{0x34, 0x12}
+		if order == binary.BigEndian {
+			want = []byte{0x12, 0x34}
+		}
+		if !bytes.Equal(data[2:4], want) {
+			t.Errorf("%v: WriteU16 wrote % x, want % x", order, data[2:4], want)
+		}
+
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		r := NewBinaryReader(f.stream, order)
+		u8, _ := r.ReadU8()
+		s8, _ := r.ReadS8()
+		u16, _ := r.ReadU16()
+		s16, _ := r.ReadS16()
+		u32, _ := r.ReadU32()
+		s32, _ := r.ReadS32()
+		u64, _ := r.ReadU64()
+		s64, _ := r.ReadS64()
+		f32, _ := r.ReadF32()
+		f64, err := r.ReadF64()
+		if err != nil {
+			t.Fatalf("%v: read: %v", order, err)
+		}
+		if u8 != 0xAB || s8 != -5 || u16 != 0x1234 || s16 != -300 || u32 != 0xDEADBEEF || s32 != -70000 ||
+			u64 != 0x0102030405060708 || s64 != math.MinInt64 || f32 != 1.5 || f64 != -math.Pi {
+			t.Errorf("%v: read %#x %d %#x %d %#x %d %#x %d %v %v", order, u8, s8, u16, s16, u32, s32, u64, s64, f32, f64)
+		}
+		var got binaryHeader
+		if err := r.ReadStruct(&got); err != nil || got != header {
+			t.Errorf("%v: ReadStruct = %+v, %v, want %+v", order, got, err, header)
+		}
+		if b, err := r.ReadBytes(3); err != nil || string(b) != "end" {
+			t.Errorf("%v: ReadBytes = %q, %v", order, b, err)
+		}
+	}
+}
+
+func TestBinaryReaderEOF(t *testing.T) {
+	f := memIO(t, []byte{1, 2, 3}, true)
+	r := NewBinaryReader(f.stream, binary.LittleEndian)
+	if _, err := r.ReadU32(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadU32 of 3 bytes = %v, want io.ErrUnexpectedEOF", err)
+	}
+	if _, err := r.ReadU8(); err != io.EOF {
+		t.Errorf("ReadU8 at the end = %v, want io.EOF", err)
+	}
+}
+
+func TestBinaryReaderNegativeCount(t *testing.T) {
+	var r BinaryReader
+	if b, err := r.ReadBytes(-1); b != nil || err == nil {
+		t.Errorf("ReadBytes(-1) = %v, %v, want an error", b, err)
+	}
+}
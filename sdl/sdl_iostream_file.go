@@ -0,0 +1,59 @@
+package sdl
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// copyLoaded copies data that was loaded by SDL into a Go slice and frees it.
+func copyLoaded(data unsafe.Pointer, size uint64) []byte {
+	defer Free(data)
+	result := make([]byte, size)
+	copy(result, unsafe.Slice((*byte)(data), size))
+	return result
+}
+
+// ReadFile reads the whole file at path, like [os.ReadFile], but through SDL, so it also finds files
+// in the assets of an Android app.
+func ReadFile(path string) ([]byte, error) {
+	var size uint64
+	data := LoadFile(path, &size)
+	if data == nil {
+		return nil, fmt.Errorf("sdl: read file %s: %s", path, GetError())
+	}
+	return copyLoaded(data, size), nil
+}
+
+// ReadFileIO reads all the remaining data of src. If closeio is true, src is closed, even in case of an error.
+func ReadFileIO(src *IOStream, closeio bool) ([]byte, error) {
+	var size uint64
+	data := LoadFileIO(src, &size, closeio)
+	if data == nil {
+		return nil, fmt.Errorf("sdl: read stream: %s", GetError())
+	}
+	return copyLoaded(data, size), nil
+}
+
+// WriteFile creates or replaces the file at path with data.
+func WriteFile(path string, data []byte) error {
+	var ptr unsafe.Pointer
+	if len(data) > 0 {
+		ptr = unsafe.Pointer(&data[0])
+	}
+	if !SaveFile(path, ptr, uint64(len(data))) {
+		return fmt.Errorf("sdl: write file %s: %s", path, GetError())
+	}
+	return nil
+}
+
+// WriteFileIO writes data to dst. If closeio is true, dst is closed, even in case of an error.
+func WriteFileIO(dst *IOStream, data []byte, closeio bool) error {
+	var ptr unsafe.Pointer
+	if len(data) > 0 {
+		ptr = unsafe.Pointer(&data[0])
+	}
+	if !SaveFileIO(dst, ptr, uint64(len(data)), closeio) {
+		return fmt.Errorf("sdl: write stream: %s", GetError())
+	}
+	return nil
+}
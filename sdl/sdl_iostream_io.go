@@ -0,0 +1,162 @@
+package sdl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var (
+	// ErrNotReady is returned when a non-blocking stream has no data available right now.
+	ErrNotReady = errors.New("sdl: stream is not ready")
+	// ErrReadOnly is returned when writing to a read-only stream.
+	ErrReadOnly = errors.New("sdl: stream is read-only")
+	// ErrWriteOnly is returned when reading from a write-only stream.
+	ErrWriteOnly = errors.New("sdl: stream is write-only")
+)
+
+// IO wraps an [IOStream] to make it usable with the standard library. It implements
+// [io.Reader], [io.Writer], [io.Seeker], [io.Closer] and [io.ReaderAt].
+//
+// Like the stream itself, IO is not safe for concurrent use, ReadAt included.
+type IO struct {
+	stream *IOStream
+}
+
+// WrapIO wraps a stream. Closing the wrapper closes the stream.
+func WrapIO(stream *IOStream) *IO {
+	return &IO{stream: stream}
+}
+
+// Stream returns the wrapped stream, or nil after the wrapper was closed.
+func (f *IO) Stream() *IOStream {
+	return f.stream
+}
+
+// statusError converts the status of the stream after a short read or write into an error.
+func (f *IO) statusError(op string) error {
+	switch GetIOStatus(f.stream) {
+	case IOStatusEof:
+		return io.EOF
+	case IOStatusNotReady:
+		return ErrNotReady
+	case IOStatusReadOnly:
+		return ErrReadOnly
+	case IOStatusWriteOnly:
+		return ErrWriteOnly
+	case IOStatusError:
+		if msg := GetError(); msg != "" {
+			return fmt.Errorf("sdl: %s: %s", op, msg)
+		}
+		return fmt.Errorf("sdl: %s failed", op)
+	}
+	return nil
+}
+
+// Read reads up to len(p) bytes. At the end of the data it returns [io.EOF], and if a non-blocking
+// stream has no data available it returns [ErrNotReady].
+func (f *IO) Read(p []byte) (int, error) {
+	if f.stream == nil {
+		return 0, os.ErrClosed
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	n := int(ReadIO(f.stream, p))
+	if n > 0 {
+		return n, nil
+	}
+	if err := f.statusError("read"); err != nil {
+		return 0, err
+	}
+	return 0, io.EOF
+}
+
+// Write writes p to the stream.
+func (f *IO) Write(p []byte) (int, error) {
+	if f.stream == nil {
+		return 0, os.ErrClosed
+	}
+	n := int(WriteIO(f.stream, p))
+	if n < len(p) {
+		if err := f.statusError("write"); err != nil && err != io.EOF {
+			return n, err
+		}
+		return n, io.ErrShortWrite
+	}
+	return n, nil
+}
+
+// Seek implements [io.Seeker]. The whence values of package io match [IOWhence].
+func (f *IO) Seek(offset int64, whence int) (int64, error) {
+	if f.stream == nil {
+		return 0, os.ErrClosed
+	}
+	pos := SeekIO(f.stream, offset, IOWhence(whence))
+	if pos < 0 {
+		return 0, fmt.Errorf("sdl: seek: %s", GetError())
+	}
+	return pos, nil
+}
+
+// ReadAt reads len(p) bytes at offset off. It moves the stream position temporarily and restores it afterwards.
+func (f *IO) ReadAt(p []byte, off int64) (int, error) {
+	if f.stream == nil {
+		return 0, os.ErrClosed
+	}
+	if off < 0 {
+		return 0, fmt.Errorf("sdl: read at negative offset %d", off)
+	}
+	pos, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := f.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(f, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	if _, seekErr := f.Seek(pos, io.SeekStart); seekErr != nil && err == nil {
+		err = seekErr
+	}
+	return n, err
+}
+
+// Flush flushes buffered data to the underlying storage.
+func (f *IO) Flush() error {
+	if f.stream == nil {
+		return os.ErrClosed
+	}
+	if !FlushIO(f.stream) {
+		return fmt.Errorf("sdl: flush: %s", GetError())
+	}
+	return nil
+}
+
+// Size returns the size of the data, or an error if it is unknown.
+func (f *IO) Size() (int64, error) {
+	if f.stream == nil {
+		return 0, os.ErrClosed
+	}
+	size := GetIOSize(f.stream)
+	if size < 0 {
+		return 0, fmt.Errorf("sdl: size: %s", GetError())
+	}
+	return size, nil
+}
+
+// Close closes the stream. Data that is still buffered is written first.
+func (f *IO) Close() error {
+	if f.stream == nil {
+		return os.ErrClosed
+	}
+	stream := f.stream
+	f.stream = nil
+	if !CloseIO(stream) {
+		return fmt.Errorf("sdl: close: %s", GetError())
+	}
+	return nil
+}
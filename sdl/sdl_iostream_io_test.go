@@ -0,0 +1,90 @@
+package sdl
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+// memIO wraps a memory stream over data. The test is skipped if SDL can't create it, e.g. without a real SDL library.
+func memIO(t *testing.T, data []byte, readOnly bool) *IO {
+	t.Helper()
+	var stream *IOStream
+	if readOnly {
+		stream = IOFromConstMem(data)
+	} else {
+		stream = IOFromMem(data)
+	}
+	if stream == nil {
+		t.Skipf("SDL can't create a memory stream: %s", GetError())
+	}
+	f := WrapIO(stream)
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func TestIORoundTrip(t *testing.T) {
+	f := memIO(t, make([]byte, 16), false)
+	if n, err := f.Write([]byte("hello world")); n != 11 || err != nil {
+		t.Fatalf("Write = %d, %v", n, err)
+	}
+	if pos, err := f.Seek(6, io.SeekStart); pos != 6 || err != nil {
+		t.Fatalf("Seek = %d, %v", pos, err)
+	}
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(f, buf); err != nil || string(buf) != "world" {
+		t.Fatalf("Read = %q, %v, want \"world\"", buf, err)
+	}
+	if size, err := f.Size(); size != 16 || err != nil {
+		t.Errorf("Size = %d, %v, want 16", size, err)
+	}
+}
+
+func TestIOEOF(t *testing.T) {
+	f := memIO(t, []byte("data"), true)
+	data, err := io.ReadAll(f)
+	if err != nil || string(data) != "data" {
+		t.Fatalf("ReadAll = %q, %v", data, err)
+	}
+	if n, err := f.Read(make([]byte, 4)); n != 0 || err != io.EOF {
+		t.Errorf("Read at the end = %d, %v, want io.EOF", n, err)
+	}
+}
+
+func TestIOReadAt(t *testing.T) {
+	f := memIO(t, []byte("0123456789"), true)
+	if _, err := f.Seek(2, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 3)
+	if n, err := f.ReadAt(buf, 5); n != 3 || err != nil || string(buf) != "567" {
+		t.Errorf("ReadAt = %d, %q, %v, want \"567\"", n, buf, err)
+	}
+	if pos, _ := f.Seek(0, io.SeekCurrent); pos != 2 {
+		t.Errorf("position after ReadAt = %d, want 2", pos)
+	}
+	if n, err := f.ReadAt(buf, 8); n != 2 || err != io.EOF {
+		t.Errorf("ReadAt past the end = %d, %v, want 2, io.EOF", n, err)
+	}
+}
+
+func TestIOConstMemWrite(t *testing.T) {
+	f := memIO(t, []byte("constant"), true)
+	if n, err := f.Write([]byte("x")); n != 0 || err == nil {
+		t.Errorf("Write to constant memory = %d, %v, want an error", n, err)
+	}
+}
+
+func TestIOCloseTwice(t *testing.T) {
+	f := memIO(t, []byte("data"), true)
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("second Close = %v, want os.ErrClosed", err)
+	}
+	if _, err := f.Read(make([]byte, 1)); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("Read after Close = %v, want os.ErrClosed", err)
+	}
+}
@@ -0,0 +1,166 @@
+package sdl
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+// setIOError stores err as SDL error, so that it shows up in [GetError] after a failed stream operation.
+func setIOError(err error) {
+	// the message is used as format string by SDL
+	sdlSetError(strings.ReplaceAll(err.Error(), "%", "%%"))
+}
+
+// goStream adapts Go I/O interfaces to an [IOStreamInterface]. Only the non-nil parts are exposed to SDL.
+type goStream struct {
+	r   io.Reader
+	w   io.Writer
+	s   io.Seeker
+	c   io.Closer
+	pos int64
+}
+
+func (g *goStream) iface() IOStreamInterface {
+	iface := IOStreamInterface{
+		Size:  g.size,
+		Seek:  g.seek,
+		Flush: g.flush,
+		Close: g.close,
+	}
+	if g.r != nil {
+		iface.Read = g.read
+	}
+	if g.w != nil {
+		iface.Write = g.write
+	}
+	return iface
+}
+
+func (g *goStream) size() int64 {
+	if sizer, ok := g.s.(interface{ Size() int64 }); ok {
+		return sizer.Size()
+	}
+	if g.s == nil {
+		return -1
+	}
+	cur, err := g.s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return -1
+	}
+	end, err := g.s.Seek(0, io.SeekEnd)
+	if err != nil {
+		return -1
+	}
+	if _, err := g.s.Seek(cur, io.SeekStart); err != nil {
+		setIOError(err)
+		return -1
+	}
+	return end
+}
+
+func (g *goStream) seek(offset int64, whence IOWhence) int64 {
+	if g.s == nil {
+		// without a seeker only the current position can be queried, which is what TellIO does
+		if offset == 0 && whence == IOSeekCur {
+			return g.pos
+		}
+		SetError("%s", "seeking is not supported")
+		return -1
+	}
+	pos, err := g.s.Seek(offset, int(whence))
+	if err != nil {
+		setIOError(err)
+		return -1
+	}
+	g.pos = pos
+	return pos
+}
+
+func (g *goStream) read(p []byte) (int, IOStatus) {
+	// SDL expects full reads, e.g. when reading a 4 byte integer, so keep reading until p is full
+	n, err := io.ReadFull(g.r, p)
+	g.pos += int64(n)
+	switch {
+	case err == nil:
+		return n, IOStatusReady
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		if n > 0 {
+			// report the end of the data with the next read
+			return n, IOStatusReady
+		}
+		return 0, IOStatusEof
+	default:
+		setIOError(err)
+		return n, IOStatusError
+	}
+}
+
+func (g *goStream) write(p []byte) (int, IOStatus) {
+	n, err := g.w.Write(p)
+	g.pos += int64(n)
+	if err != nil {
+		setIOError(err)
+		return n, IOStatusError
+	}
+	return n, IOStatusReady
+}
+
+func (g *goStream) flush() IOStatus {
+	if flusher, ok := g.w.(interface{ Flush() error }); ok {
+		if err := flusher.Flush(); err != nil {
+			setIOError(err)
+			return IOStatusError
+		}
+	}
+	return IOStatusReady
+}
+
+func (g *goStream) close() bool {
+	status := true
+	if g.w != nil && g.flush() != IOStatusReady {
+		status = false
+	}
+	if g.c != nil {
+		if err := g.c.Close(); err != nil {
+			setIOError(err)
+			status = false
+		}
+	}
+	return status
+}
+
+// IOFromReader creates a read-only [IOStream] that reads from r.
+//
+// The stream can't seek, so it only works with functions that read their input front to back.
+// If r implements [io.Closer], it is closed together with the stream.
+func IOFromReader(r io.Reader) *IOStream {
+	g := &goStream{r: r}
+	g.c, _ = r.(io.Closer)
+	return OpenIO(g.iface())
+}
+
+// IOFromReadSeeker creates a read-only, seekable [IOStream] that reads from rs, like a file from an [embed.FS]
+// or a [bytes.Reader]. If rs implements [io.Closer], it is closed together with the stream.
+//
+// Example:
+//
+//	f, _ := assets.Open("font.ttf")
+//	font := ttf.OpenFontIO(sdl.IOFromReadSeeker(f.(io.ReadSeeker)), true, 16)
+func IOFromReadSeeker(rs io.ReadSeeker) *IOStream {
+	g := &goStream{r: rs, s: rs}
+	g.c, _ = rs.(io.Closer)
+	return OpenIO(g.iface())
+}
+
+// IOFromWriter creates a write-only [IOStream] that writes to w.
+//
+// [FlushIO] calls w.Flush if w has such a method, like a [bufio.Writer].
+// If w also implements [io.Seeker] the stream is seekable, and if it implements [io.Closer],
+// it is closed together with the stream.
+func IOFromWriter(w io.Writer) *IOStream {
+	g := &goStream{w: w}
+	g.s, _ = w.(io.Seeker)
+	g.c, _ = w.(io.Closer)
+	return OpenIO(g.iface())
+}
@@ -1,8 +1,11 @@
 package sdl
 
 import (
+	"sync"
 	"unsafe"
 
+	"github.com/ebitengine/purego"
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
 	"github.com/jupiterrider/purego-sdl3/internal/mem"
 )
 
@@ -69,9 +72,9 @@ func GetJoystickPlayerIndexForID(instanceId JoystickID) int32 {
 	return sdlGetJoystickPlayerIndexForID(instanceId)
 }
 
-// func GetJoystickGUIDForID(instanceId JoystickID) GUID {
-// 	return sdlGetJoystickGUIDForID(instanceId)
-// }
+func GetJoystickGUIDForID(instanceId JoystickID) GUID {
+	return guidCall(sdlGetJoystickGUIDForID, uintptr(instanceId))
+}
 
 func GetJoystickVendorForID(instanceId JoystickID) uint16 {
 	return sdlGetJoystickVendorForID(instanceId)
@@ -101,55 +104,289 @@ func GetJoystickFromPlayerIndex(playerIndex int32) *Joystick {
 	return sdlGetJoystickFromPlayerIndex(playerIndex)
 }
 
-// type VirtualJoystickTouchpadDesc struct {
-// 	NFingers uint16
-// 	Padding1 uint16
-// 	Padding2 uint16
-// 	Padding3 uint16
-// }
+type VirtualJoystickTouchpadDesc struct {
+	NFingers uint16
+	Padding1 uint16
+	Padding2 uint16
+	Padding3 uint16
+}
+
+type VirtualJoystickSensorDesc struct {
+	Type SensorType
+	Rate float32
+}
 
-// type VirtualJoystickSensorDesc struct {
-// 	Type SensorType
-// 	Rate float32
-// }
+// VirtualJoystickDesc describes a virtual joystick for [AttachVirtualJoystick].
 //
-// type VirtualJoystickDesc struct{}
+// The callbacks are optional. They are called by SDL on the thread that updates the joysticks.
+// Leaving e.g. Rumble nil tells SDL that the virtual joystick does not support rumble.
+type VirtualJoystickDesc struct {
+	// Type is usually [JoystickTypeGamepad].
+	Type      JoystickType
+	VendorID  uint16
+	ProductID uint16
+	NAxes     uint16
+	NButtons  uint16
+	NBalls    uint16
+	NHats     uint16
+	// ButtonMask is a mask of which buttons are valid for this controller, e.g. (1 << GamepadButtonSouth).
+	ButtonMask uint32
+	// AxisMask is a mask of which axes are valid for this controller, e.g. (1 << GamepadAxisLeftX).
+	AxisMask  uint32
+	Name      string
+	Touchpads []VirtualJoystickTouchpadDesc
+	Sensors   []VirtualJoystickSensorDesc
+
+	// Update is called at least once per frame to let the virtual joystick report its state.
+	Update            func()
+	SetPlayerIndex    func(playerIndex int32)
+	Rumble            func(lowFrequencyRumble, highFrequencyRumble uint16) bool
+	RumbleTriggers    func(leftRumble, rightRumble uint16) bool
+	SetLED            func(red, green, blue uint8) bool
+	SendEffect        func(data []byte) bool
+	SetSensorsEnabled func(enabled bool) bool
+	// Cleanup is called when the virtual joystick is detached.
+	Cleanup func()
+}
+
+// virtualJoystickDesc is the C layout of SDL_VirtualJoystickDesc.
+type virtualJoystickDesc struct {
+	version           uint32
+	joystickType      uint16
+	padding           uint16
+	vendorID          uint16
+	productID         uint16
+	naxes             uint16
+	nbuttons          uint16
+	nballs            uint16
+	nhats             uint16
+	ntouchpads        uint16
+	nsensors          uint16
+	padding2          [2]uint16
+	buttonMask        uint32
+	axisMask          uint32
+	name              *byte
+	touchpads         *VirtualJoystickTouchpadDesc
+	sensors           *VirtualJoystickSensorDesc
+	userdata          uintptr
+	update            uintptr
+	setPlayerIndex    uintptr
+	rumble            uintptr
+	rumbleTriggers    uintptr
+	setLED            uintptr
+	sendEffect        uintptr
+	setSensorsEnabled uintptr
+	cleanup           uintptr
+}
+
+// virtualJoysticks maps the userdata passed to SDL to the Go description, because purego callbacks
+// can't be released and are therefore created only once for all virtual joysticks.
+var virtualJoysticks = struct {
+	sync.Mutex
+	next  uintptr
+	descs map[uintptr]*VirtualJoystickDesc
+}{descs: make(map[uintptr]*VirtualJoystickDesc)}
+
+func lookupVirtualJoystick(userdata uintptr) *VirtualJoystickDesc {
+	virtualJoysticks.Lock()
+	defer virtualJoysticks.Unlock()
+	return virtualJoysticks.descs[userdata]
+}
+
+var virtualJoystickCallbacks struct {
+	once                                                                                           sync.Once
+	update, setPlayerIndex, rumble, rumbleTriggers, setLED, sendEffect, setSensorsEnabled, cleanup uintptr
+}
+
+func initVirtualJoystickCallbacks() {
+	cb := &virtualJoystickCallbacks
+	cb.update = purego.NewCallback(func(userdata uintptr) uintptr {
+		if desc := lookupVirtualJoystick(userdata); desc != nil && desc.Update != nil {
+			desc.Update()
+		}
+		return 0
+	})
+	cb.setPlayerIndex = purego.NewCallback(func(userdata uintptr, playerIndex int32) uintptr {
+		if desc := lookupVirtualJoystick(userdata); desc != nil && desc.SetPlayerIndex != nil {
+			desc.SetPlayerIndex(playerIndex)
+		}
+		return 0
+	})
+	cb.rumble = purego.NewCallback(func(userdata uintptr, low, high uint16) uintptr {
+		desc := lookupVirtualJoystick(userdata)
+		return boolToUintptr(desc != nil && desc.Rumble != nil && desc.Rumble(low, high))
+	})
+	cb.rumbleTriggers = purego.NewCallback(func(userdata uintptr, left, right uint16) uintptr {
+		desc := lookupVirtualJoystick(userdata)
+		return boolToUintptr(desc != nil && desc.RumbleTriggers != nil && desc.RumbleTriggers(left, right))
+	})
+	cb.setLED = purego.NewCallback(func(userdata uintptr, red, green, blue uint8) uintptr {
+		desc := lookupVirtualJoystick(userdata)
+		return boolToUintptr(desc != nil && desc.SetLED != nil && desc.SetLED(red, green, blue))
+	})
+	cb.sendEffect = purego.NewCallback(func(userdata uintptr, data *byte, size int32) uintptr {
+		desc := lookupVirtualJoystick(userdata)
+		if desc == nil || desc.SendEffect == nil {
+			return 0
+		}
+		var buf []byte
+		if data != nil && size > 0 {
+			buf = make([]byte, size)
+			copy(buf, unsafe.Slice(data, size))
+		}
+		return boolToUintptr(desc.SendEffect(buf))
+	})
+	cb.setSensorsEnabled = purego.NewCallback(func(userdata uintptr, enabled bool) uintptr {
+		desc := lookupVirtualJoystick(userdata)
+		return boolToUintptr(desc != nil && desc.SetSensorsEnabled != nil && desc.SetSensorsEnabled(enabled))
+	})
+	cb.cleanup = purego.NewCallback(func(userdata uintptr) uintptr {
+		virtualJoysticks.Lock()
+		desc := virtualJoysticks.descs[userdata]
+		delete(virtualJoysticks.descs, userdata)
+		virtualJoysticks.Unlock()
+		if desc != nil && desc.Cleanup != nil {
+			desc.Cleanup()
+		}
+		return 0
+	})
+}
+
+func boolToUintptr(b bool) uintptr {
+	if b {
+		return 1
+	}
+	return 0
+}
 
-// func AttachVirtualJoystick(desc *VirtualJoystickDesc) JoystickID {
-// 	return sdlAttachVirtualJoystick(desc)
-// }
+// [AttachVirtualJoystick] attaches a new virtual joystick.
+//
+// Returns the joystick instance ID or 0 on failure.
+//
+// [AttachVirtualJoystick]: https://wiki.libsdl.org/SDL3/SDL_AttachVirtualJoystick
+func AttachVirtualJoystick(desc *VirtualJoystickDesc) JoystickID {
+	callbacks := &virtualJoystickCallbacks
+	callbacks.once.Do(initVirtualJoystickCallbacks)
+
+	virtualJoysticks.Lock()
+	virtualJoysticks.next++
+	userdata := virtualJoysticks.next
+	virtualJoysticks.descs[userdata] = desc
+	virtualJoysticks.Unlock()
+
+	c := virtualJoystickDesc{
+		joystickType: uint16(desc.Type),
+		vendorID:     desc.VendorID,
+		productID:    desc.ProductID,
+		naxes:        desc.NAxes,
+		nbuttons:     desc.NButtons,
+		nballs:       desc.NBalls,
+		nhats:        desc.NHats,
+		ntouchpads:   uint16(len(desc.Touchpads)),
+		nsensors:     uint16(len(desc.Sensors)),
+		buttonMask:   desc.ButtonMask,
+		axisMask:     desc.AxisMask,
+		name:         convert.ToBytePtrNullable(desc.Name),
+		userdata:     userdata,
+		cleanup:      callbacks.cleanup,
+	}
+	c.version = uint32(unsafe.Sizeof(c))
+	if len(desc.Touchpads) > 0 {
+		c.touchpads = &desc.Touchpads[0]
+	}
+	if len(desc.Sensors) > 0 {
+		c.sensors = &desc.Sensors[0]
+	}
+	if desc.Update != nil {
+		c.update = callbacks.update
+	}
+	if desc.SetPlayerIndex != nil {
+		c.setPlayerIndex = callbacks.setPlayerIndex
+	}
+	if desc.Rumble != nil {
+		c.rumble = callbacks.rumble
+	}
+	if desc.RumbleTriggers != nil {
+		c.rumbleTriggers = callbacks.rumbleTriggers
+	}
+	if desc.SetLED != nil {
+		c.setLED = callbacks.setLED
+	}
+	if desc.SendEffect != nil {
+		c.sendEffect = callbacks.sendEffect
+	}
+	if desc.SetSensorsEnabled != nil {
+		c.setSensorsEnabled = callbacks.setSensorsEnabled
+	}
 
-// func DetachVirtualJoystick(instance_id JoystickID) bool {
-// 	return sdlDetachVirtualJoystick(instance_id)
-// }
+	id := sdlAttachVirtualJoystick(&c)
+	if id == 0 {
+		virtualJoysticks.Lock()
+		delete(virtualJoysticks.descs, userdata)
+		virtualJoysticks.Unlock()
+	}
+	return id
+}
 
-// func IsJoystickVirtual(instance_id JoystickID) bool {
-// 	return sdlIsJoystickVirtual(instance_id)
-// }
+// [DetachVirtualJoystick] detaches a virtual joystick.
+//
+// [DetachVirtualJoystick]: https://wiki.libsdl.org/SDL3/SDL_DetachVirtualJoystick
+func DetachVirtualJoystick(instanceId JoystickID) bool {
+	return sdlDetachVirtualJoystick(instanceId)
+}
 
-// func SetJoystickVirtualAxis(joystick *Joystick, axis int32, value int16) bool {
-// 	return sdlSetJoystickVirtualAxis(joystick, axis, value)
-// }
+// [IsJoystickVirtual] queries whether or not a joystick is virtual.
+//
+// [IsJoystickVirtual]: https://wiki.libsdl.org/SDL3/SDL_IsJoystickVirtual
+func IsJoystickVirtual(instanceId JoystickID) bool {
+	return sdlIsJoystickVirtual(instanceId)
+}
+
+// [SetJoystickVirtualAxis] sets the state of an axis on an opened virtual joystick.
+//
+// [SetJoystickVirtualAxis]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualAxis
+func SetJoystickVirtualAxis(joystick *Joystick, axis int32, value int16) bool {
+	return sdlSetJoystickVirtualAxis(joystick, axis, value)
+}
 
-// func SetJoystickVirtualBall(joystick *Joystick, ball int32, xrel int16, yrel int16) bool {
-// 	return sdlSetJoystickVirtualBall(joystick, ball, xrel, yrel)
-// }
+// [SetJoystickVirtualBall] generates ball motion on an opened virtual joystick.
+//
+// [SetJoystickVirtualBall]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualBall
+func SetJoystickVirtualBall(joystick *Joystick, ball int32, xrel, yrel int16) bool {
+	return sdlSetJoystickVirtualBall(joystick, ball, xrel, yrel)
+}
 
-// func SetJoystickVirtualButton(joystick *Joystick, button int32, down bool) bool {
-// 	return sdlSetJoystickVirtualButton(joystick, button, down)
-// }
+// [SetJoystickVirtualButton] sets the state of a button on an opened virtual joystick.
+//
+// [SetJoystickVirtualButton]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualButton
+func SetJoystickVirtualButton(joystick *Joystick, button int32, down bool) bool {
+	return sdlSetJoystickVirtualButton(joystick, button, down)
+}
 
-// func SetJoystickVirtualHat(joystick *Joystick, hat int32, value uint8) bool {
-// 	return sdlSetJoystickVirtualHat(joystick, hat, value)
-// }
+// [SetJoystickVirtualHat] sets the state of a hat on an opened virtual joystick.
+//
+// [SetJoystickVirtualHat]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualHat
+func SetJoystickVirtualHat(joystick *Joystick, hat int32, value uint8) bool {
+	return sdlSetJoystickVirtualHat(joystick, hat, value)
+}
 
-// func SetJoystickVirtualTouchpad(joystick *Joystick, touchpad int32, finger int32, down bool, x float32, y float32, pressure float32) bool {
-// 	return sdlSetJoystickVirtualTouchpad(joystick, touchpad, finger, down, x, y, pressure)
-// }
+// [SetJoystickVirtualTouchpad] sets touchpad finger state on an opened virtual joystick.
+//
+// [SetJoystickVirtualTouchpad]: https://wiki.libsdl.org/SDL3/SDL_SetJoystickVirtualTouchpad
+func SetJoystickVirtualTouchpad(joystick *Joystick, touchpad, finger int32, down bool, x, y, pressure float32) bool {
+	return sdlSetJoystickVirtualTouchpad(joystick, touchpad, finger, down, x, y, pressure)
+}
 
-// func SendJoystickVirtualSensorData(joystick *Joystick, sensorType SensorType, sensor_timestamp uint64, data *float32, num_values int32) bool {
-// 	return sdlSendJoystickVirtualSensorData(joystick, sensorType, sensor_timestamp, data, num_values)
-// }
+// [SendJoystickVirtualSensorData] sends a sensor update for an opened virtual joystick.
+//
+// [SendJoystickVirtualSensorData]: https://wiki.libsdl.org/SDL3/SDL_SendJoystickVirtualSensorData
+func SendJoystickVirtualSensorData(joystick *Joystick, sensorType SensorType, sensorTimestamp uint64, data []float32) bool {
+	var ptr *float32
+	if len(data) > 0 {
+		ptr = &data[0]
+	}
+	return sdlSendJoystickVirtualSensorData(joystick, sensorType, sensorTimestamp, ptr, int32(len(data)))
+}
 
 func GetJoystickProperties(joystick *Joystick) PropertiesID {
 	return sdlGetJoystickProperties(joystick)
@@ -179,9 +416,9 @@ func SetJoystickPlayerIndex(joystick *Joystick, playerIndex int32) bool {
 	return sdlSetJoystickPlayerIndex(joystick, playerIndex)
 }
 
-// func GetJoystickGUID(joystick *Joystick) GUID {
-// 	return sdlGetJoystickGUID(joystick)
-// }
+func GetJoystickGUID(joystick *Joystick) GUID {
+	return guidCall(sdlGetJoystickGUID, uintptr(unsafe.Pointer(joystick)))
+}
 
 func GetJoystickVendor(joystick *Joystick) uint16 {
 	return sdlGetJoystickVendor(joystick)
@@ -207,9 +444,23 @@ func GetJoystickType(joystick *Joystick) JoystickType {
 	return sdlGetJoystickType(joystick)
 }
 
-// func GetJoystickGUIDInfo(guid GUID, vendor *uint16, product *uint16, version *uint16, crc16 *uint16) {
-// 	sdlGetJoystickGUIDInfo(guid, vendor, product, version, crc16)
-// }
+// GetJoystickGUIDInfo gets the device information encoded in a joystick GUID. Any of the output pointers may be nil.
+// See [GUID.Info] for a version that doesn't call into SDL.
+func GetJoystickGUIDInfo(guid GUID, vendor *uint16, product *uint16, version *uint16, crc16 *uint16) {
+	// the pointers are converted in the argument lists, so that they stay valid during the calls
+	words := guidWords(&guid)
+	switch {
+	case guidByReference:
+		purego.SyscallN(sdlGetJoystickGUIDInfo, uintptr(unsafe.Pointer(&guid)),
+			uintptr(unsafe.Pointer(vendor)), uintptr(unsafe.Pointer(product)), uintptr(unsafe.Pointer(version)), uintptr(unsafe.Pointer(crc16)))
+	case guidWordCount == 4:
+		purego.SyscallN(sdlGetJoystickGUIDInfo, words[0], words[1], words[2], words[3],
+			uintptr(unsafe.Pointer(vendor)), uintptr(unsafe.Pointer(product)), uintptr(unsafe.Pointer(version)), uintptr(unsafe.Pointer(crc16)))
+	default:
+		purego.SyscallN(sdlGetJoystickGUIDInfo, words[0], words[1],
+			uintptr(unsafe.Pointer(vendor)), uintptr(unsafe.Pointer(product)), uintptr(unsafe.Pointer(version)), uintptr(unsafe.Pointer(crc16)))
+	}
+}
 
 func JoystickConnected(joystick *Joystick) bool {
 	return sdlJoystickConnected(joystick)
@@ -0,0 +1,104 @@
+package sdl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeyChord is a key combined with modifiers, like "Ctrl+Shift+S".
+type KeyChord struct {
+	Mod Keymod
+	Key Keycode
+}
+
+// keymodGroups are the modifiers that take part in chord matching. Lock keys like [KeymodCaps] are ignored.
+var keymodGroups = [...]Keymod{KeymodCtrl, KeymodShift, KeymodAlt, KeymodGui}
+
+// ParseKeyChord parses a chord like "Ctrl+Shift+S", "Cmd+Q" or "Ctrl++".
+// The last part is the key name as understood by [ParseKeycode], everything before are modifiers as understood by [ParseKeymod].
+func ParseKeyChord(s string) (KeyChord, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return KeyChord{}, fmt.Errorf("sdl: empty key chord")
+	}
+
+	// the key itself may be "+", so split at the last "+" that is not the final character
+	var mods, key string
+	if i := strings.LastIndex(s[:len(s)-1], "+"); i >= 0 {
+		mods, key = s[:i], s[i+1:]
+	} else {
+		key = s
+	}
+
+	var chord KeyChord
+	if mods != "" {
+		mod, err := ParseKeymod(mods)
+		if err != nil {
+			return KeyChord{}, err
+		}
+		chord.Mod = mod
+	}
+	keycode, err := ParseKeycode(strings.TrimSpace(key))
+	if err != nil {
+		return KeyChord{}, err
+	}
+	chord.Key = keycode
+	return chord, nil
+}
+
+// String formats the chord like "Ctrl+Shift+S". Lock modifiers are left out.
+func (c KeyChord) String() string {
+	var sb strings.Builder
+	if mod := c.Mod & (KeymodCtrl | KeymodShift | KeymodAlt | KeymodGui | KeymodMode | KeymodLevel5); mod != KeymodNone {
+		sb.WriteString(mod.String())
+		sb.WriteByte('+')
+	}
+	sb.WriteString(c.Key.String())
+	return sb.String()
+}
+
+func (c KeyChord) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *KeyChord) UnmarshalText(text []byte) error {
+	chord, err := ParseKeyChord(string(text))
+	if err != nil {
+		return err
+	}
+	*c = chord
+	return nil
+}
+
+// Matches reports whether a key and modifier state match the chord.
+//
+// A generic modifier like [KeymodCtrl] accepts either side, while [KeymodLCtrl] requires the left key.
+// Modifiers that are not part of the chord must not be held, except for lock keys.
+func (c KeyChord) Matches(key Keycode, mod Keymod) bool {
+	if key != c.Key {
+		return false
+	}
+	for _, group := range keymodGroups {
+		want, have := c.Mod&group, mod&group
+		switch {
+		case want == 0:
+			if have != 0 {
+				return false
+			}
+		case want == group:
+			if have == 0 {
+				return false
+			}
+		default:
+			if have&want == 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MatchesEvent reports whether a key down event matches the chord. See [KeyChord.Matches].
+func (c KeyChord) MatchesEvent(event *KeyboardEvent) bool {
+	return event.Down && c.Matches(event.Key, event.Mod)
+}
@@ -0,0 +1,214 @@
+package sdl
+
+import "strings"
+
+// TextComposition tracks the state of an input method editor (IME) for one window.
+//
+// Feed every event to [TextComposition.HandleEvent]. While the user composes text, [TextComposition.Text]
+// holds the pre-edit string that should be drawn at the caret, usually underlined, with the selected part
+// from [TextComposition.Segments] highlighted. Finished text is collected until [TextComposition.Commit] is called.
+//
+// Example:
+//
+//	comp := sdl.NewTextComposition(window)
+//	comp.Start(0)
+//	defer comp.Stop()
+//	for sdl.PollEvent(&event) {
+//		comp.HandleEvent(&event)
+//	}
+//	buffer += comp.Commit()
+//	comp.SetCaret(caretRect, 0)
+//
+// The zero value accepts events of every window, but reports a cursor, selection length and selected candidate of 0
+// instead of -1 until the first composition ends. Use [NewTextComposition] to start without a cursor.
+type TextComposition struct {
+	// Window is the window that receives text input. If it is nil, events of every window are accepted,
+	// but Start, Stop, Cancel and SetCaret fail, because SDL needs a window to control the IME.
+	Window *Window
+
+	text      string
+	cursor    int32
+	length    int32
+	committed strings.Builder
+
+	candidates        []string
+	selectedCandidate int32
+	horizontal        bool
+
+	area       Rect
+	areaCursor int32
+	areaValid  bool
+}
+
+// NewTextComposition creates a composition tracker for a window.
+// If window is nil, events of every window are accepted, but the IME can't be controlled.
+func NewTextComposition(window *Window) *TextComposition {
+	return &TextComposition{Window: window, cursor: -1, length: -1, selectedCandidate: -1}
+}
+
+// Start starts text input on the window. If props is not 0, it is passed on to [StartTextInputWithProperties].
+func (t *TextComposition) Start(props PropertiesID) bool {
+	if !t.hasWindow() {
+		return false
+	}
+	t.areaValid = false
+	if props != 0 {
+		return StartTextInputWithProperties(t.Window, props)
+	}
+	return StartTextInput(t.Window)
+}
+
+// Stop stops text input on the window and discards an unfinished composition.
+func (t *TextComposition) Stop() bool {
+	t.reset()
+	if !t.hasWindow() {
+		return false
+	}
+	return StopTextInput(t.Window)
+}
+
+// Cancel discards the current composition, both in the IME and in the tracker.
+func (t *TextComposition) Cancel() bool {
+	t.reset()
+	if !t.hasWindow() {
+		return false
+	}
+	return ClearComposition(t.Window)
+}
+
+// hasWindow reports whether a window is set and sets the SDL error otherwise.
+func (t *TextComposition) hasWindow() bool {
+	if t.Window == nil {
+		return InvalidParamError("window")
+	}
+	return true
+}
+
+func (t *TextComposition) reset() {
+	t.text = ""
+	t.cursor = -1
+	t.length = -1
+	t.candidates = nil
+	t.selectedCandidate = -1
+}
+
+func (t *TextComposition) accepts(windowID WindowID) bool {
+	return t.Window == nil || GetWindowID(t.Window) == windowID
+}
+
+// HandleEvent updates the composition from [EventTextEditing], [EventTextEditingCandidates] and [EventTextInput]
+// and reports whether the event was consumed.
+func (t *TextComposition) HandleEvent(event *Event) bool {
+	switch event.Type() {
+	case EventTextEditing:
+		edit := event.Edit()
+		if !t.accepts(edit.WindowID) {
+			return false
+		}
+		t.text = edit.Text()
+		t.cursor = edit.Start
+		t.length = edit.Length
+		if t.text == "" {
+			t.reset()
+		}
+	case EventTextEditingCandidates:
+		candidates := event.EditCandidates()
+		if !t.accepts(candidates.WindowID) {
+			return false
+		}
+		t.candidates = candidates.Candidates()
+		t.selectedCandidate = candidates.SelectedCandidate
+		t.horizontal = candidates.Horizontal
+	case EventTextInput:
+		input := event.Text()
+		if !t.accepts(input.WindowID) {
+			return false
+		}
+		t.committed.WriteString(input.Text())
+		t.reset()
+	default:
+		return false
+	}
+	return true
+}
+
+// Composing reports whether the user is in the middle of composing text.
+func (t *TextComposition) Composing() bool {
+	return t.text != ""
+}
+
+// Text returns the pre-edit string.
+func (t *TextComposition) Text() string {
+	return t.text
+}
+
+// Cursor returns the cursor position within the pre-edit string in runes, or -1 if it is not set.
+// The zero value of [TextComposition] returns 0 until the first composition ends.
+func (t *TextComposition) Cursor() int32 {
+	return t.cursor
+}
+
+// SelectionLength returns the length of the selected part of the pre-edit string in runes, or -1 if it is not set.
+func (t *TextComposition) SelectionLength() int32 {
+	return t.length
+}
+
+// Segments splits the pre-edit string into the part before the selection, the selection and the part after it.
+// Without a selection, the string is split at the cursor and selected is empty.
+func (t *TextComposition) Segments() (before, selected, after string) {
+	runes := []rune(t.text)
+	start := int(t.cursor)
+	if start < 0 || start > len(runes) {
+		start = len(runes)
+	}
+	end := start
+	if t.length > 0 {
+		end = start + int(t.length)
+		if end > len(runes) {
+			end = len(runes)
+		}
+	}
+	return string(runes[:start]), string(runes[start:end]), string(runes[end:])
+}
+
+// Candidates returns the candidate list shown by the IME, which is only available if the
+// application draws the candidate list itself (see [HintImeImplementedUi]).
+func (t *TextComposition) Candidates() []string {
+	return t.candidates
+}
+
+// SelectedCandidate returns the index of the selected candidate or -1 if none is selected.
+func (t *TextComposition) SelectedCandidate() int32 {
+	return t.selectedCandidate
+}
+
+// CandidatesHorizontal reports whether the candidate list should be laid out horizontally.
+func (t *TextComposition) CandidatesHorizontal() bool {
+	return t.horizontal
+}
+
+// Commit returns the text committed since the previous call and clears it.
+func (t *TextComposition) Commit() string {
+	text := t.committed.String()
+	t.committed.Reset()
+	return text
+}
+
+// SetCaret tells the IME where the text is being entered, so that the candidate window can be placed next to it.
+// area is the input area in window coordinates and cursor the caret offset in pixels relative to area.X.
+// [SetTextInputArea] is only called if the values changed since the previous call.
+func (t *TextComposition) SetCaret(area Rect, cursor int32) bool {
+	if !t.hasWindow() {
+		return false
+	}
+	if t.areaValid && t.area == area && t.areaCursor == cursor {
+		return true
+	}
+	if !SetTextInputArea(t.Window, &area, cursor) {
+		return false
+	}
+	t.area = area
+	t.areaCursor = cursor
+	t.areaValid = true
+	return true
+}
@@ -1,5 +1,11 @@
 package sdl
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type Keycode uint32
 
 const (
@@ -285,3 +291,147 @@ const (
 	KeymodAlt   Keymod = KeymodLAlt | KeymodRAlt
 	KeymodGui   Keymod = KeymodLGui | KeymodRGui
 )
+
+// String returns the human-readable name of the key as reported by [GetKeyName].
+// Keys without a name are formatted as their number in hexadecimal, e.g. "0x40000123".
+// [ParseKeycode] accepts both forms.
+func (k Keycode) String() string {
+	if name := GetKeyName(k); name != "" {
+		return name
+	}
+	return "0x" + strconv.FormatUint(uint64(k), 16)
+}
+
+// MarshalText returns the same text as [Keycode.String].
+func (k Keycode) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText accepts a key name as understood by [GetKeyFromName] or a number.
+func (k *Keycode) UnmarshalText(text []byte) error {
+	key, err := ParseKeycode(string(text))
+	if err != nil {
+		return err
+	}
+	*k = key
+	return nil
+}
+
+// ParseKeycode parses a key name as understood by [GetKeyFromName] or a number (decimal or with a 0x prefix).
+// Numbers with a 0x prefix are parsed first, decimal numbers only if they aren't a key name like "1".
+func ParseKeycode(s string) (Keycode, error) {
+	if strings.HasPrefix(s, "0x") {
+		n, err := strconv.ParseUint(s[2:], 16, 32)
+		if err != nil {
+			return KeycodeUnknown, fmt.Errorf("sdl: invalid key number %q", s)
+		}
+		return Keycode(n), nil
+	}
+	if key := GetKeyFromName(s); key != KeycodeUnknown {
+		return key, nil
+	}
+	if n, err := strconv.ParseUint(s, 0, 32); err == nil && len(s) > 1 {
+		return Keycode(n), nil
+	}
+	return KeycodeUnknown, fmt.Errorf("sdl: unknown key %q", s)
+}
+
+var keymodNames = []struct {
+	mod  Keymod
+	name string
+}{
+	{KeymodCtrl, "Ctrl"},
+	{KeymodLCtrl, "LCtrl"},
+	{KeymodRCtrl, "RCtrl"},
+	{KeymodShift, "Shift"},
+	{KeymodLShift, "LShift"},
+	{KeymodRShift, "RShift"},
+	{KeymodAlt, "Alt"},
+	{KeymodLAlt, "LAlt"},
+	{KeymodRAlt, "RAlt"},
+	{KeymodGui, "Gui"},
+	{KeymodLGui, "LGui"},
+	{KeymodRGui, "RGui"},
+	{KeymodMode, "Mode"},
+	{KeymodLevel5, "Level5"},
+	{KeymodNum, "Num"},
+	{KeymodCaps, "Caps"},
+	{KeymodScroll, "Scroll"},
+}
+
+var keymodAliases = map[string]Keymod{
+	"control":  KeymodCtrl,
+	"lcontrol": KeymodLCtrl,
+	"rcontrol": KeymodRCtrl,
+	"option":   KeymodAlt,
+	"cmd":      KeymodGui,
+	"command":  KeymodGui,
+	"super":    KeymodGui,
+	"win":      KeymodGui,
+	"meta":     KeymodGui,
+}
+
+// String returns the modifiers joined with "+", e.g. "Ctrl+LShift". Modifiers pressed on both sides
+// are combined into their generic name. KeymodNone is formatted as "None".
+func (m Keymod) String() string {
+	if m == KeymodNone {
+		return "None"
+	}
+	var names []string
+	for _, v := range keymodNames {
+		if m&v.mod == v.mod {
+			names = append(names, v.name)
+			m &^= v.mod
+		}
+	}
+	if m != 0 {
+		names = append(names, "0x"+strconv.FormatUint(uint64(m), 16))
+	}
+	return strings.Join(names, "+")
+}
+
+func (m Keymod) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Keymod) UnmarshalText(text []byte) error {
+	mod, err := ParseKeymod(string(text))
+	if err != nil {
+		return err
+	}
+	*m = mod
+	return nil
+}
+
+// ParseKeymod parses modifiers joined with "+" as returned by [Keymod.String].
+// Names are case-insensitive and common aliases like "Control", "Cmd" or "Super" are accepted.
+func ParseKeymod(s string) (Keymod, error) {
+	var result Keymod
+	for _, part := range strings.Split(s, "+") {
+		mod, ok := parseKeymodName(strings.TrimSpace(part))
+		if !ok {
+			return KeymodNone, fmt.Errorf("sdl: unknown key modifier %q", part)
+		}
+		result |= mod
+	}
+	return result, nil
+}
+
+func parseKeymodName(name string) (Keymod, bool) {
+	lower := strings.ToLower(name)
+	if lower == "none" || lower == "" {
+		return KeymodNone, lower == "none"
+	}
+	for _, v := range keymodNames {
+		if strings.ToLower(v.name) == lower {
+			return v.mod, true
+		}
+	}
+	if mod, ok := keymodAliases[lower]; ok {
+		return mod, true
+	}
+	if n, err := strconv.ParseUint(lower, 0, 16); err == nil {
+		return Keymod(n), true
+	}
+	return KeymodNone, false
+}
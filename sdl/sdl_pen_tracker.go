@@ -0,0 +1,261 @@
+package sdl
+
+import "sort"
+
+// PenState is the state of a single pen as seen through its events.
+type PenState struct {
+	ID       PenID
+	WindowID WindowID
+	// InProximity reports whether the pen is close enough to the tablet to be tracked.
+	InProximity bool
+	// Flags holds the input flags of the most recent event (tip down, buttons, eraser).
+	Flags PenInputFlags
+	X     float32
+	Y     float32
+	Axes  [PenAxisCount]float32
+	// Timestamp in nanoseconds of the most recent event.
+	Timestamp uint64
+}
+
+// Down reports whether the pen tip (or eraser) is touching the tablet.
+func (p *PenState) Down() bool {
+	return p.Flags&PenInputDown != 0
+}
+
+// Eraser reports whether the eraser end of the pen is being used.
+func (p *PenState) Eraser() bool {
+	return p.Flags&PenInputEraserTip != 0
+}
+
+// ButtonDown reports whether the given pen button (1...5) is pressed.
+func (p *PenState) ButtonDown(button uint8) bool {
+	if button < 1 || button > 5 {
+		return false
+	}
+	return p.Flags&(PenInputDown<<button) != 0
+}
+
+// Pressure is a shorthand for the value of [PenAxisPressure] (0...1).
+func (p *PenState) Pressure() float32 {
+	return p.Axes[PenAxisPressure]
+}
+
+// PenSample is a single point of a [PenStroke].
+type PenSample struct {
+	X        float32
+	Y        float32
+	Pressure float32
+	// Timestamp in nanoseconds.
+	Timestamp uint64
+}
+
+// PenStroke is the path of a pen from touching the tablet until it was lifted again.
+type PenStroke struct {
+	Pen      PenID
+	WindowID WindowID
+	Eraser   bool
+	Samples  []PenSample
+}
+
+// PenTracker keeps track of the state of all pens by consuming pen events.
+//
+// Feed every event to [PenTracker.HandleEvent] and query the current state with [PenTracker.Pen] or [PenTracker.Pens].
+// If RecordStrokes is set, every contact of a pen with the tablet is recorded as a [PenStroke].
+// The zero value is an empty tracker that doesn't record strokes.
+type PenTracker struct {
+	RecordStrokes bool
+	pens          map[PenID]*PenState
+	active        map[PenID]*PenStroke
+	strokes       []PenStroke
+}
+
+// NewPenTracker creates an empty tracker.
+func NewPenTracker(recordStrokes bool) *PenTracker {
+	return &PenTracker{
+		RecordStrokes: recordStrokes,
+		pens:          make(map[PenID]*PenState),
+		active:        make(map[PenID]*PenStroke),
+	}
+}
+
+// HandleEvent updates the tracker from a pen event and reports whether the event was a pen event.
+func (t *PenTracker) HandleEvent(event *Event) bool {
+	switch event.Type() {
+	case EventPenProximityIn, EventPenProximityOut:
+		e := event.PProximity()
+		t.HandleProximity(&e)
+	case EventPenDown, EventPenUp:
+		e := event.PTouch()
+		t.HandleTouch(&e)
+	case EventPenButtonDown, EventPenButtonUp:
+		e := event.PButton()
+		t.HandleButton(&e)
+	case EventPenMotion:
+		e := event.PMotion()
+		t.HandleMotion(&e)
+	case EventPenAxis:
+		e := event.PAxis()
+		t.HandleAxis(&e)
+	default:
+		return false
+	}
+	return true
+}
+
+// HandleProximity processes [EventPenProximityIn] and [EventPenProximityOut].
+func (t *PenTracker) HandleProximity(event *PenProximityEvent) {
+	pen := t.pen(event.Which, event.WindowID, event.Timestamp)
+	pen.InProximity = event.Type == EventPenProximityIn
+	if !pen.InProximity {
+		pen.Flags = 0
+		t.endStroke(event.Which)
+	}
+}
+
+// HandleTouch processes [EventPenDown] and [EventPenUp].
+func (t *PenTracker) HandleTouch(event *PenTouchEvent) {
+	pen := t.pen(event.Which, event.WindowID, event.Timestamp)
+	pen.InProximity = true
+	pen.Flags = event.PenState
+	pen.X, pen.Y = event.X, event.Y
+	if event.Eraser {
+		pen.Flags |= PenInputEraserTip
+	} else {
+		pen.Flags &^= PenInputEraserTip
+	}
+	if event.Down {
+		pen.Flags |= PenInputDown
+		t.beginStroke(pen)
+	} else {
+		pen.Flags &^= PenInputDown
+		t.addSample(pen)
+		t.endStroke(event.Which)
+	}
+}
+
+// HandleButton processes [EventPenButtonDown] and [EventPenButtonUp].
+func (t *PenTracker) HandleButton(event *PenButtonEvent) {
+	pen := t.pen(event.Which, event.WindowID, event.Timestamp)
+	pen.InProximity = true
+	pen.Flags = event.PenState
+	pen.X, pen.Y = event.X, event.Y
+	if event.Button >= 1 && event.Button <= 5 {
+		if event.Down {
+			pen.Flags |= PenInputDown << event.Button
+		} else {
+			pen.Flags &^= PenInputDown << event.Button
+		}
+	}
+}
+
+// HandleMotion processes [EventPenMotion].
+func (t *PenTracker) HandleMotion(event *PenMotionEvent) {
+	pen := t.pen(event.Which, event.WindowID, event.Timestamp)
+	pen.InProximity = true
+	pen.Flags = event.PenState
+	pen.X, pen.Y = event.X, event.Y
+	t.addSample(pen)
+}
+
+// HandleAxis processes [EventPenAxis].
+func (t *PenTracker) HandleAxis(event *PenAxisEvent) {
+	pen := t.pen(event.Which, event.WindowID, event.Timestamp)
+	pen.InProximity = true
+	pen.Flags = event.PenState
+	pen.X, pen.Y = event.X, event.Y
+	if event.Axis < PenAxisCount {
+		pen.Axes[event.Axis] = event.Value
+	}
+	if event.Axis == PenAxisPressure {
+		t.addSample(pen)
+	}
+}
+
+// Pen returns the state of the given pen and whether the pen has been seen at all.
+func (t *PenTracker) Pen(id PenID) (PenState, bool) {
+	pen, ok := t.pens[id]
+	if !ok {
+		return PenState{}, false
+	}
+	return *pen, true
+}
+
+// Pens returns the state of all known pens, ordered by their ID.
+func (t *PenTracker) Pens() []PenState {
+	pens := make([]PenState, 0, len(t.pens))
+	for _, pen := range t.pens {
+		pens = append(pens, *pen)
+	}
+	sort.Slice(pens, func(i, j int) bool { return pens[i].ID < pens[j].ID })
+	return pens
+}
+
+// CurrentStroke returns the stroke the given pen is drawing right now, if any.
+func (t *PenTracker) CurrentStroke(id PenID) (PenStroke, bool) {
+	stroke, ok := t.active[id]
+	if !ok {
+		return PenStroke{}, false
+	}
+	result := *stroke
+	result.Samples = append([]PenSample(nil), stroke.Samples...)
+	return result, true
+}
+
+// Strokes returns all completed strokes and removes them from the tracker.
+func (t *PenTracker) Strokes() []PenStroke {
+	strokes := t.strokes
+	t.strokes = nil
+	return strokes
+}
+
+// Forget removes a pen and discards its unfinished stroke.
+func (t *PenTracker) Forget(id PenID) {
+	delete(t.pens, id)
+	delete(t.active, id)
+}
+
+func (t *PenTracker) pen(id PenID, windowID WindowID, timestamp uint64) *PenState {
+	if t.pens == nil {
+		t.pens = make(map[PenID]*PenState)
+		t.active = make(map[PenID]*PenStroke)
+	}
+	pen, ok := t.pens[id]
+	if !ok {
+		pen = &PenState{ID: id}
+		t.pens[id] = pen
+	}
+	pen.WindowID = windowID
+	pen.Timestamp = timestamp
+	return pen
+}
+
+func (t *PenTracker) beginStroke(pen *PenState) {
+	if !t.RecordStrokes {
+		return
+	}
+	t.endStroke(pen.ID)
+	t.active[pen.ID] = &PenStroke{Pen: pen.ID, WindowID: pen.WindowID, Eraser: pen.Eraser()}
+	t.addSample(pen)
+}
+
+func (t *PenTracker) addSample(pen *PenState) {
+	stroke, ok := t.active[pen.ID]
+	if !ok {
+		return
+	}
+	stroke.Samples = append(stroke.Samples, PenSample{
+		X:         pen.X,
+		Y:         pen.Y,
+		Pressure:  pen.Pressure(),
+		Timestamp: pen.Timestamp,
+	})
+}
+
+func (t *PenTracker) endStroke(id PenID) {
+	stroke, ok := t.active[id]
+	if !ok {
+		return
+	}
+	delete(t.active, id)
+	t.strokes = append(t.strokes, *stroke)
+}
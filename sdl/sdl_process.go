@@ -1,5 +1,15 @@
 package sdl
 
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
+)
+
+// Process is a child process, see [Command] for a Go friendly way to start one.
+type Process struct{}
+
 type ProcessIO uint32
 
 const (
@@ -9,38 +19,98 @@ const (
 	ProcessStdioRedirect
 )
 
-// func CreateProcess(args **byte, pipe_stdio bool) *Process {
-//	return sdlCreateProcess(args, pipe_stdio)
-// }
+const (
+	PropProcessCreateArgsPointer            = "SDL.process.create.args"
+	PropProcessCreateEnvironmentPointer     = "SDL.process.create.environment"
+	PropProcessCreateWorkingDirectoryString = "SDL.process.create.working_directory"
+	PropProcessCreateStdinNumber            = "SDL.process.create.stdin_option"
+	PropProcessCreateStdinPointer           = "SDL.process.create.stdin_source"
+	PropProcessCreateStdoutNumber           = "SDL.process.create.stdout_option"
+	PropProcessCreateStdoutPointer          = "SDL.process.create.stdout_source"
+	PropProcessCreateStderrNumber           = "SDL.process.create.stderr_option"
+	PropProcessCreateStderrPointer          = "SDL.process.create.stderr_source"
+	PropProcessCreateStderrToStdoutBoolean  = "SDL.process.create.stderr_to_stdout"
+	PropProcessCreateBackgroundBoolean      = "SDL.process.create.background"
+	PropProcessPIDNumber                    = "SDL.process.pid"
+	PropProcessStdinPointer                 = "SDL.process.stdin"
+	PropProcessStdoutPointer                = "SDL.process.stdout"
+	PropProcessStderrPointer                = "SDL.process.stderr"
+	PropProcessBackgroundBoolean            = "SDL.process.background"
+)
+
+// cStringArray converts strings to a NULL-terminated array of C strings. The result must be kept alive
+// as long as SDL uses it.
+func cStringArray(strs []string) []*byte {
+	array := make([]*byte, len(strs)+1)
+	for i, str := range strs {
+		array[i] = convert.ToBytePtr(str)
+	}
+	return array
+}
+
+// [CreateProcess] creates a new process. args[0] is the program to run. If pipeStdio is true,
+// the input and output of the process can be accessed with [GetProcessInput] and [GetProcessOutput].
+//
+// [CreateProcess]: https://wiki.libsdl.org/SDL3/SDL_CreateProcess
+func CreateProcess(args []string, pipeStdio bool) *Process {
+	array := cStringArray(args)
+	defer runtime.KeepAlive(array)
+	return sdlCreateProcess(&array[0], pipeStdio)
+}
 
-// func CreateProcessWithProperties(props PropertiesID) *Process {
-//	return sdlCreateProcessWithProperties(props)
-// }
+// [CreateProcessWithProperties] creates a new process with the specified properties, see the PropProcessCreate constants.
+//
+// [CreateProcessWithProperties]: https://wiki.libsdl.org/SDL3/SDL_CreateProcessWithProperties
+func CreateProcessWithProperties(props PropertiesID) *Process {
+	return sdlCreateProcessWithProperties(props)
+}
 
-// func DestroyProcess(process *Process)  {
-//	sdlDestroyProcess(process)
-// }
+// [DestroyProcess] stops tracking a process and frees it. The process keeps running.
+//
+// [DestroyProcess]: https://wiki.libsdl.org/SDL3/SDL_DestroyProcess
+func DestroyProcess(process *Process) {
+	sdlDestroyProcess(process)
+}
 
-// func GetProcessInput(process *Process) *IOStream {
-//	return sdlGetProcessInput(process)
-// }
+// [GetProcessInput] gets the stream connected to the input of a process that was created with [ProcessStdioApp].
+//
+// [GetProcessInput]: https://wiki.libsdl.org/SDL3/SDL_GetProcessInput
+func GetProcessInput(process *Process) *IOStream {
+	return sdlGetProcessInput(process)
+}
 
-// func GetProcessOutput(process *Process) *IOStream {
-//	return sdlGetProcessOutput(process)
-// }
+// [GetProcessOutput] gets the stream connected to the output of a process that was created with [ProcessStdioApp].
+//
+// [GetProcessOutput]: https://wiki.libsdl.org/SDL3/SDL_GetProcessOutput
+func GetProcessOutput(process *Process) *IOStream {
+	return sdlGetProcessOutput(process)
+}
 
-// func GetProcessProperties(process *Process) PropertiesID {
-//	return sdlGetProcessProperties(process)
-// }
+// [GetProcessProperties] gets the properties associated with a process, see the PropProcess constants.
+//
+// [GetProcessProperties]: https://wiki.libsdl.org/SDL3/SDL_GetProcessProperties
+func GetProcessProperties(process *Process) PropertiesID {
+	return sdlGetProcessProperties(process)
+}
 
-// func KillProcess(process *Process, force bool) bool {
-//	return sdlKillProcess(process, force)
-// }
+// [KillProcess] stops a process. If force is false, the process is asked to quit.
+//
+// [KillProcess]: https://wiki.libsdl.org/SDL3/SDL_KillProcess
+func KillProcess(process *Process, force bool) bool {
+	return sdlKillProcess(process, force)
+}
 
-// func ReadProcess(process *Process, datasize *uint64, exitcode *int32) unsafe.Pointer {
-//	return sdlReadProcess(process, datasize, exitcode)
-// }
+// [ReadProcess] reads all the output from a process and waits for it to exit. The returned memory
+// has to be freed with [Free]. exitcode may be nil.
+//
+// [ReadProcess]: https://wiki.libsdl.org/SDL3/SDL_ReadProcess
+func ReadProcess(process *Process, dataSize *uint64, exitcode *int32) unsafe.Pointer {
+	return sdlReadProcess(process, dataSize, exitcode)
+}
 
-// func WaitProcess(process *Process, block bool, exitcode *int32) bool {
-//	return sdlWaitProcess(process, block, exitcode)
-// }
+// [WaitProcess] waits for a process to finish and reports whether it has exited. exitcode may be nil.
+//
+// [WaitProcess]: https://wiki.libsdl.org/SDL3/SDL_WaitProcess
+func WaitProcess(process *Process, block bool, exitcode *int32) bool {
+	return sdlWaitProcess(process, block, exitcode)
+}
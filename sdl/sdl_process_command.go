@@ -0,0 +1,290 @@
+package sdl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+// Command describes a process to start, similar to [exec.Cmd]. Create it with [NewCommand], adjust the fields
+// and call [Command.Start] or [Command.Run].
+//
+// Example:
+//
+//	cmd := sdl.NewCommand("git", "status", "--short")
+//	cmd.Dir = projectDir
+//	out, exitCode, err := cmd.Output()
+//
+// Streaming input and output:
+//
+//	cmd := sdl.NewCommand("sort")
+//	cmd.Stdin = sdl.ProcessStdioApp
+//	cmd.Stdout = sdl.ProcessStdioApp
+//	err := cmd.Start()
+//	go func() {
+//		io.WriteString(cmd.InputPipe(), "b\na\n")
+//		cmd.InputPipe().Close()
+//	}()
+//	sorted, err := io.ReadAll(cmd.OutputPipe())
+//	exitCode, err := cmd.Wait()
+type Command struct {
+	// Args holds the program in Args[0] and its arguments.
+	Args []string
+	// Env holds the environment of the process as "name=value" entries. If nil, the process inherits
+	// the environment of the app.
+	Env []string
+	// Dir is the working directory of the process. Empty means the current directory of the app.
+	// It requires SDL 3.4 or newer, older versions ignore it.
+	Dir string
+
+	// Stdin, Stdout and Stderr select where the standard streams of the process are connected to.
+	// With [ProcessStdioApp] they are available through [Command.InputPipe], [Command.OutputPipe]
+	// and [Command.ErrorPipe], with [ProcessStdioRedirect] the stream in the matching field below is used.
+	Stdin, Stdout, Stderr ProcessIO
+	// StdinStream, StdoutStream and StderrStream are the targets of [ProcessStdioRedirect]. They must be backed
+	// by a file or pipe, like the streams of [IOFromFile] or of another process.
+	StdinStream, StdoutStream, StderrStream *IOStream
+	// StderrToStdout sends the error output to the same place as the output. Stderr is ignored then.
+	StderrToStdout bool
+	// Background detaches the process from the app, so that it keeps running after the app exits.
+	// The exit code of a background process is always 0.
+	Background bool
+
+	process               *Process
+	stdin, stdout, stderr *processPipe
+}
+
+// processPipe is a stream of a process. SDL creates them non-blocking, so like SDL_LoadFile_IO, reads and writes
+// wait a moment and retry while the pipe is empty or full.
+type processPipe struct {
+	*IO
+}
+
+// Read reads up to len(b) bytes, waiting until data is available. It returns [io.EOF] after the process
+// closed its end of the pipe.
+func (p *processPipe) Read(b []byte) (int, error) {
+	for {
+		n, err := p.IO.Read(b)
+		if err != ErrNotReady {
+			return n, err
+		}
+		Delay(1)
+	}
+}
+
+// Write writes all of b, waiting while the pipe is full.
+func (p *processPipe) Write(b []byte) (int, error) {
+	written := 0
+	for {
+		n, err := p.IO.Write(b[written:])
+		written += n
+		if err != ErrNotReady {
+			return written, err
+		}
+		Delay(1)
+	}
+}
+
+// NewCommand creates a command that runs name with args. Like with SDL's defaults, the input of the
+// process is connected to the null device and the output and error output are inherited from the app.
+func NewCommand(name string, args ...string) *Command {
+	return &Command{
+		Args:   append([]string{name}, args...),
+		Stdin:  ProcessStdioNull,
+		Stdout: ProcessStdioInherited,
+		Stderr: ProcessStdioInherited,
+	}
+}
+
+func setProcessStdio(props PropertiesID, optionName, sourceName string, option ProcessIO, source *IOStream) error {
+	if option == ProcessStdioRedirect {
+		if source == nil {
+			return errors.New("sdl: redirect without a stream")
+		}
+		SetPointerProperty(props, sourceName, unsafe.Pointer(source))
+	}
+	SetNumberProperty(props, optionName, int64(option))
+	return nil
+}
+
+// Start starts the process without waiting for it. Call [Command.Wait] or [Command.Release] afterwards
+// to free its resources.
+func (c *Command) Start() error {
+	if c.process != nil {
+		return errors.New("sdl: process already started")
+	}
+	if len(c.Args) == 0 {
+		return errors.New("sdl: no program to run")
+	}
+
+	props := CreateProperties()
+	if props == 0 {
+		return fmt.Errorf("sdl: start %s: %s", c.Args[0], GetError())
+	}
+	defer DestroyProperties(props)
+
+	// SDL only uses the arguments and the environment while creating the process
+	args := cStringArray(c.Args)
+	defer runtime.KeepAlive(args)
+	SetPointerProperty(props, PropProcessCreateArgsPointer, unsafe.Pointer(&args[0]))
+	if c.Env != nil {
+		env := CreateEnvironment(false)
+		if env == nil {
+			return fmt.Errorf("sdl: start %s: %s", c.Args[0], GetError())
+		}
+		defer DestroyEnvironment(env)
+		for _, variable := range c.Env {
+			name, value, _ := strings.Cut(variable, "=")
+			SetEnvironmentVariable(env, name, value, true)
+		}
+		SetPointerProperty(props, PropProcessCreateEnvironmentPointer, unsafe.Pointer(env))
+	}
+	if c.Dir != "" {
+		SetStringProperty(props, PropProcessCreateWorkingDirectoryString, c.Dir)
+	}
+	if err := setProcessStdio(props, PropProcessCreateStdinNumber, PropProcessCreateStdinPointer, c.Stdin, c.StdinStream); err != nil {
+		return fmt.Errorf("sdl: start %s: stdin: %w", c.Args[0], err)
+	}
+	if err := setProcessStdio(props, PropProcessCreateStdoutNumber, PropProcessCreateStdoutPointer, c.Stdout, c.StdoutStream); err != nil {
+		return fmt.Errorf("sdl: start %s: stdout: %w", c.Args[0], err)
+	}
+	if c.StderrToStdout {
+		SetBooleanProperty(props, PropProcessCreateStderrToStdoutBoolean, true)
+	} else if err := setProcessStdio(props, PropProcessCreateStderrNumber, PropProcessCreateStderrPointer, c.Stderr, c.StderrStream); err != nil {
+		return fmt.Errorf("sdl: start %s: stderr: %w", c.Args[0], err)
+	}
+	SetBooleanProperty(props, PropProcessCreateBackgroundBoolean, c.Background)
+
+	process := CreateProcessWithProperties(props)
+	if process == nil {
+		return fmt.Errorf("sdl: start %s: %s", c.Args[0], GetError())
+	}
+	c.process = process
+	if c.Stdin == ProcessStdioApp {
+		c.stdin = &processPipe{WrapIO(GetProcessInput(process))}
+	}
+	if c.Stdout == ProcessStdioApp {
+		c.stdout = &processPipe{WrapIO(GetProcessOutput(process))}
+	}
+	if c.Stderr == ProcessStdioApp && !c.StderrToStdout {
+		stream := GetPointerProperty(GetProcessProperties(process), PropProcessStderrPointer, nil)
+		c.stderr = &processPipe{WrapIO((*IOStream)(stream))}
+	}
+	return nil
+}
+
+// Process returns the started process, or nil.
+func (c *Command) Process() *Process {
+	return c.process
+}
+
+// PID returns the process ID of the started process, or 0.
+func (c *Command) PID() int64 {
+	if c.process == nil {
+		return 0
+	}
+	return GetNumberProperty(GetProcessProperties(c.process), PropProcessPIDNumber, 0)
+}
+
+// InputPipe returns the input of the process if Stdin is [ProcessStdioApp], otherwise nil.
+// Writes block while the pipe is full. Close it to signal the end of the input to the process.
+func (c *Command) InputPipe() io.WriteCloser {
+	if c.stdin == nil {
+		return nil
+	}
+	return c.stdin
+}
+
+// OutputPipe returns the output of the process if Stdout is [ProcessStdioApp], otherwise nil.
+// Reads block until the process wrote data or closed its output.
+func (c *Command) OutputPipe() io.Reader {
+	if c.stdout == nil {
+		return nil
+	}
+	return c.stdout
+}
+
+// ErrorPipe returns the error output of the process if Stderr is [ProcessStdioApp], otherwise nil.
+// Reads block like those of [Command.OutputPipe].
+func (c *Command) ErrorPipe() io.Reader {
+	if c.stderr == nil {
+		return nil
+	}
+	return c.stderr
+}
+
+// Exited reports without blocking whether the process has exited and its exit code.
+func (c *Command) Exited() (exitCode int32, exited bool) {
+	if c.process == nil {
+		return 0, false
+	}
+	exited = WaitProcess(c.process, false, &exitCode)
+	return exitCode, exited
+}
+
+// Wait waits for the process to exit, returns its exit code and releases it.
+func (c *Command) Wait() (int32, error) {
+	if c.process == nil {
+		return 0, errors.New("sdl: process not started")
+	}
+	var exitCode int32
+	if !WaitProcess(c.process, true, &exitCode) {
+		return 0, fmt.Errorf("sdl: wait for %s: %s", c.Args[0], GetError())
+	}
+	c.Release()
+	return exitCode, nil
+}
+
+// Kill stops the process. If force is false, the process is asked to quit, otherwise it is terminated.
+func (c *Command) Kill(force bool) error {
+	if c.process == nil {
+		return errors.New("sdl: process not started")
+	}
+	if !KillProcess(c.process, force) {
+		return fmt.Errorf("sdl: kill %s: %s", c.Args[0], GetError())
+	}
+	return nil
+}
+
+// Release frees the process without waiting for it, which keeps running. The pipes are closed.
+func (c *Command) Release() {
+	if c.process == nil {
+		return
+	}
+	// the streams are owned by the process
+	for _, pipe := range []*processPipe{c.stdin, c.stdout, c.stderr} {
+		if pipe != nil {
+			pipe.stream = nil
+		}
+	}
+	c.stdin, c.stdout, c.stderr = nil, nil, nil
+	DestroyProcess(c.process)
+	c.process = nil
+}
+
+// Run starts the process and waits for it to exit.
+func (c *Command) Run() (int32, error) {
+	if err := c.Start(); err != nil {
+		return 0, err
+	}
+	return c.Wait()
+}
+
+// Output starts the process with Stdout set to [ProcessStdioApp], reads all of its output and waits for it to exit.
+func (c *Command) Output() ([]byte, int32, error) {
+	c.Stdout = ProcessStdioApp
+	if err := c.Start(); err != nil {
+		return nil, 0, err
+	}
+	defer c.Release()
+	var size uint64
+	var exitCode int32
+	data := ReadProcess(c.process, &size, &exitCode)
+	if data == nil {
+		return nil, 0, fmt.Errorf("sdl: read output of %s: %s", c.Args[0], GetError())
+	}
+	return copyLoaded(data, size), exitCode, nil
+}
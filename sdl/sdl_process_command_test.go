@@ -0,0 +1,73 @@
+package sdl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"testing"
+)
+
+// pipeCommand creates a command with input and output connected to the app. The test is skipped without
+// a real SDL library or if the program isn't installed.
+func pipeCommand(t *testing.T, name string) *Command {
+	t.Helper()
+	if major, _, _ := GetVersion(); major == 0 {
+		t.Skip("SDL isn't available")
+	}
+	if _, err := exec.LookPath(name); err != nil {
+		t.Skipf("%s isn't installed", name)
+	}
+	cmd := NewCommand(name)
+	cmd.Stdin = ProcessStdioApp
+	cmd.Stdout = ProcessStdioApp
+	return cmd
+}
+
+// pipeThrough writes input to the command in another goroutine and returns everything it printed.
+func pipeThrough(t *testing.T, cmd *Command, input []byte) []byte {
+	t.Helper()
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	written := make(chan error, 1)
+	go func() {
+		_, err := cmd.InputPipe().Write(input)
+		if closeErr := cmd.InputPipe().(io.Closer).Close(); err == nil {
+			err = closeErr
+		}
+		written <- err
+	}()
+	output, err := io.ReadAll(cmd.OutputPipe())
+	if err != nil {
+		t.Fatalf("read output: %v", err)
+	}
+	if err := <-written; err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	if exitCode, err := cmd.Wait(); exitCode != 0 || err != nil {
+		t.Fatalf("Wait = %d, %v", exitCode, err)
+	}
+	return output
+}
+
+func TestCommandPipesSort(t *testing.T) {
+	cmd := pipeCommand(t, "sort")
+	output := pipeThrough(t, cmd, []byte("banana\ncherry\napple\n"))
+	if string(output) != "apple\nbanana\ncherry\n" {
+		t.Errorf("output = %q", output)
+	}
+}
+
+func TestCommandPipesCat(t *testing.T) {
+	cmd := pipeCommand(t, "cat")
+	// more data than fits into a pipe, so reads and writes have to wait for the other side
+	var input bytes.Buffer
+	for i := 0; input.Len() < 1<<20; i++ {
+		fmt.Fprintf(&input, "line %d\n", i)
+	}
+	output := pipeThrough(t, cmd, input.Bytes())
+	if !bytes.Equal(output, input.Bytes()) {
+		t.Errorf("got %d bytes of output for %d bytes of input", len(output), input.Len())
+	}
+}
@@ -1,5 +1,11 @@
 package sdl
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type Scancode uint32
 
 const (
@@ -253,3 +259,322 @@ const (
 	ScancodeReserved           Scancode = 400
 	ScancodeCount              Scancode = 512
 )
+
+// scancodeNames holds the names SDL uses for its scancodes. Unlike key names, they don't depend on the keyboard layout.
+var scancodeNames = map[Scancode]string{
+	ScancodeA:                  "A",
+	ScancodeB:                  "B",
+	ScancodeC:                  "C",
+	ScancodeD:                  "D",
+	ScancodeE:                  "E",
+	ScancodeF:                  "F",
+	ScancodeG:                  "G",
+	ScancodeH:                  "H",
+	ScancodeI:                  "I",
+	ScancodeJ:                  "J",
+	ScancodeK:                  "K",
+	ScancodeL:                  "L",
+	ScancodeM:                  "M",
+	ScancodeN:                  "N",
+	ScancodeO:                  "O",
+	ScancodeP:                  "P",
+	ScancodeQ:                  "Q",
+	ScancodeR:                  "R",
+	ScancodeS:                  "S",
+	ScancodeT:                  "T",
+	ScancodeU:                  "U",
+	ScancodeV:                  "V",
+	ScancodeW:                  "W",
+	ScancodeX:                  "X",
+	ScancodeY:                  "Y",
+	ScancodeZ:                  "Z",
+	Scancode1:                  "1",
+	Scancode2:                  "2",
+	Scancode3:                  "3",
+	Scancode4:                  "4",
+	Scancode5:                  "5",
+	Scancode6:                  "6",
+	Scancode7:                  "7",
+	Scancode8:                  "8",
+	Scancode9:                  "9",
+	Scancode0:                  "0",
+	ScancodeReturn:             "Return",
+	ScancodeEscape:             "Escape",
+	ScancodeBackspace:          "Backspace",
+	ScancodeTab:                "Tab",
+	ScancodeSpace:              "Space",
+	ScancodeMinus:              "-",
+	ScancodeEquals:             "=",
+	ScancodeLeftBracket:        "[",
+	ScancodeRightBracket:       "]",
+	ScancodeBackslash:          "\\",
+	ScancodeNonUSHash:          "#",
+	ScancodeSemicolon:          ";",
+	ScancodeApostrophe:         "'",
+	ScancodeGrave:              "`",
+	ScancodeComma:              ",",
+	ScancodePeriod:             ".",
+	ScancodeSlash:              "/",
+	ScancodeCapsLock:           "CapsLock",
+	ScancodeF1:                 "F1",
+	ScancodeF2:                 "F2",
+	ScancodeF3:                 "F3",
+	ScancodeF4:                 "F4",
+	ScancodeF5:                 "F5",
+	ScancodeF6:                 "F6",
+	ScancodeF7:                 "F7",
+	ScancodeF8:                 "F8",
+	ScancodeF9:                 "F9",
+	ScancodeF10:                "F10",
+	ScancodeF11:                "F11",
+	ScancodeF12:                "F12",
+	ScancodePrintScreen:        "PrintScreen",
+	ScancodeScrollLock:         "ScrollLock",
+	ScancodePause:              "Pause",
+	ScancodeInsert:             "Insert",
+	ScancodeHome:               "Home",
+	ScancodePageUp:             "PageUp",
+	ScancodeDelete:             "Delete",
+	ScancodeEnd:                "End",
+	ScancodePageDown:           "PageDown",
+	ScancodeRight:              "Right",
+	ScancodeLeft:               "Left",
+	ScancodeDown:               "Down",
+	ScancodeUp:                 "Up",
+	ScancodeNumLockClear:       "Numlock",
+	ScancodeKpDivide:           "Keypad /",
+	ScancodeKpMultiply:         "Keypad *",
+	ScancodeKpMinus:            "Keypad -",
+	ScancodeKpPlus:             "Keypad +",
+	ScancodeKpEnter:            "Keypad Enter",
+	ScancodeKp1:                "Keypad 1",
+	ScancodeKp2:                "Keypad 2",
+	ScancodeKp3:                "Keypad 3",
+	ScancodeKp4:                "Keypad 4",
+	ScancodeKp5:                "Keypad 5",
+	ScancodeKp6:                "Keypad 6",
+	ScancodeKp7:                "Keypad 7",
+	ScancodeKp8:                "Keypad 8",
+	ScancodeKp9:                "Keypad 9",
+	ScancodeKp0:                "Keypad 0",
+	ScancodeKpPeriod:           "Keypad .",
+	ScancodeNonUSBackslash:     "NonUSBackslash",
+	ScancodeApplication:        "Application",
+	ScancodePower:              "Power",
+	ScancodeKpEquals:           "Keypad =",
+	ScancodeF13:                "F13",
+	ScancodeF14:                "F14",
+	ScancodeF15:                "F15",
+	ScancodeF16:                "F16",
+	ScancodeF17:                "F17",
+	ScancodeF18:                "F18",
+	ScancodeF19:                "F19",
+	ScancodeF20:                "F20",
+	ScancodeF21:                "F21",
+	ScancodeF22:                "F22",
+	ScancodeF23:                "F23",
+	ScancodeF24:                "F24",
+	ScancodeExecute:            "Execute",
+	ScancodeHelp:               "Help",
+	ScancodeMenu:               "Menu",
+	ScancodeSelect:             "Select",
+	ScancodeStop:               "Stop",
+	ScancodeAgain:              "Again",
+	ScancodeUndo:               "Undo",
+	ScancodeCut:                "Cut",
+	ScancodeCopy:               "Copy",
+	ScancodePaste:              "Paste",
+	ScancodeFind:               "Find",
+	ScancodeMute:               "Mute",
+	ScancodeVolumeUp:           "VolumeUp",
+	ScancodeVolumeDown:         "VolumeDown",
+	ScancodeKpComma:            "Keypad ,",
+	ScancodeKpEqualsAs400:      "Keypad = (AS400)",
+	ScancodeInternational1:     "International 1",
+	ScancodeInternational2:     "International 2",
+	ScancodeInternational3:     "International 3",
+	ScancodeInternational4:     "International 4",
+	ScancodeInternational5:     "International 5",
+	ScancodeInternational6:     "International 6",
+	ScancodeInternational7:     "International 7",
+	ScancodeInternational8:     "International 8",
+	ScancodeInternational9:     "International 9",
+	ScancodeLang1:              "Language 1",
+	ScancodeLang2:              "Language 2",
+	ScancodeLang3:              "Language 3",
+	ScancodeLang4:              "Language 4",
+	ScancodeLang5:              "Language 5",
+	ScancodeLang6:              "Language 6",
+	ScancodeLang7:              "Language 7",
+	ScancodeLang8:              "Language 8",
+	ScancodeLang9:              "Language 9",
+	ScancodeAltErase:           "AltErase",
+	ScancodeSysReq:             "SysReq",
+	ScancodeCancel:             "Cancel",
+	ScancodeClear:              "Clear",
+	ScancodePrior:              "Prior",
+	ScancodeReturn2:            "Return2",
+	ScancodeSeparator:          "Separator",
+	ScancodeOut:                "Out",
+	ScancodeOper:               "Oper",
+	ScancodeClearAgain:         "Clear / Again",
+	ScancodeCrSel:              "CrSel",
+	ScancodeExSel:              "ExSel",
+	ScancodeKp00:               "Keypad 00",
+	ScancodeKp000:              "Keypad 000",
+	ScancodeThousandsSeparator: "ThousandsSeparator",
+	ScancodeDecimalSeparator:   "DecimalSeparator",
+	ScancodeCurrencyUnit:       "CurrencyUnit",
+	ScancodeCurrencySubunit:    "CurrencySubUnit",
+	ScancodeKpLeftParen:        "Keypad (",
+	ScancodeKpRightParen:       "Keypad )",
+	ScancodeKpLeftBrace:        "Keypad {",
+	ScancodeKpRightBrace:       "Keypad }",
+	ScancodeKpTab:              "Keypad Tab",
+	ScancodeKpBackspace:        "Keypad Backspace",
+	ScancodeKpA:                "Keypad A",
+	ScancodeKpB:                "Keypad B",
+	ScancodeKpC:                "Keypad C",
+	ScancodeKpD:                "Keypad D",
+	ScancodeKpE:                "Keypad E",
+	ScancodeKpF:                "Keypad F",
+	ScancodeKpXor:              "Keypad XOR",
+	ScancodeKpPower:            "Keypad ^",
+	ScancodeKpPercent:          "Keypad %",
+	ScancodeKpLess:             "Keypad <",
+	ScancodeKpGreater:          "Keypad >",
+	ScancodeKpAmpersand:        "Keypad &",
+	ScancodeKpDblAmpersand:     "Keypad &&",
+	ScancodeKpVerticalBar:      "Keypad |",
+	ScancodeKpDblverticalBar:   "Keypad ||",
+	ScancodeKpColon:            "Keypad :",
+	ScancodeKpHash:             "Keypad #",
+	ScancodeKpSpace:            "Keypad Space",
+	ScancodeKpAt:               "Keypad @",
+	ScancodeKpExclam:           "Keypad !",
+	ScancodeKpMemStore:         "Keypad MemStore",
+	ScancodeKpMemRecall:        "Keypad MemRecall",
+	ScancodeKpMemClear:         "Keypad MemClear",
+	ScancodeKpMemAdd:           "Keypad MemAdd",
+	ScancodeKpMemSubtract:      "Keypad MemSubtract",
+	ScancodeKpMemMultiply:      "Keypad MemMultiply",
+	ScancodeKpMemDivide:        "Keypad MemDivide",
+	ScancodeKpPlusMinus:        "Keypad +/-",
+	ScancodeKpClear:            "Keypad Clear",
+	ScancodeKpClearEntry:       "Keypad ClearEntry",
+	ScancodeKpBinary:           "Keypad Binary",
+	ScancodeKpOctal:            "Keypad Octal",
+	ScancodeKpDecimal:          "Keypad Decimal",
+	ScancodeKpHexadecimal:      "Keypad Hexadecimal",
+	ScancodeLCtrl:              "Left Ctrl",
+	ScancodeLShift:             "Left Shift",
+	ScancodeLAlt:               "Left Alt",
+	ScancodeLGui:               "Left GUI",
+	ScancodeRCtrl:              "Right Ctrl",
+	ScancodeRShift:             "Right Shift",
+	ScancodeRAlt:               "Right Alt",
+	ScancodeRGui:               "Right GUI",
+	ScancodeMode:               "ModeSwitch",
+	ScancodeSleep:              "Sleep",
+	ScancodeWake:               "Wake",
+	ScancodeChannelIncrement:   "ChannelUp",
+	ScancodeChannelDecrement:   "ChannelDown",
+	ScancodeMediaPlay:          "MediaPlay",
+	ScancodeMediaPause:         "MediaPause",
+	ScancodeMediaRecord:        "MediaRecord",
+	ScancodeMediaFastForward:   "MediaFastForward",
+	ScancodeMediaRewind:        "MediaRewind",
+	ScancodeMediaNextTrack:     "MediaTrackNext",
+	ScancodeMediaPreviousTrack: "MediaTrackPrevious",
+	ScancodeMediaStop:          "MediaStop",
+	ScancodeMediaEject:         "Eject",
+	ScancodeMediaPlayPause:     "MediaPlayPause",
+	ScancodeMediaSelect:        "MediaSelect",
+	ScancodeAcNew:              "AC New",
+	ScancodeAcOpen:             "AC Open",
+	ScancodeAcClose:            "AC Close",
+	ScancodeAcExit:             "AC Exit",
+	ScancodeAcSave:             "AC Save",
+	ScancodeAcPrint:            "AC Print",
+	ScancodeAcProperties:       "AC Properties",
+	ScancodeAcSearch:           "AC Search",
+	ScancodeAcHome:             "AC Home",
+	ScancodeAcBack:             "AC Back",
+	ScancodeAcForward:          "AC Forward",
+	ScancodeAcStop:             "AC Stop",
+	ScancodeAcRefresh:          "AC Refresh",
+	ScancodeAcBookmarks:        "AC Bookmarks",
+	ScancodeSoftLeft:           "SoftLeft",
+	ScancodeSoftRight:          "SoftRight",
+	ScancodeCall:               "Call",
+	ScancodeEndCall:            "EndCall",
+}
+
+var scancodesByName = func() map[string]Scancode {
+	result := make(map[string]Scancode, len(scancodeNames))
+	for scancode, name := range scancodeNames {
+		name = strings.ToLower(name)
+		if other, ok := result[name]; !ok || scancode < other {
+			result[name] = scancode
+		}
+	}
+	return result
+}()
+
+// String returns the layout independent name of the scancode, e.g. "Left Ctrl".
+// Scancodes without a name are formatted as their number with a "#" prefix, e.g. "#1",
+// which doesn't collide with the names of the digit keys. [ParseScancode] accepts both forms.
+func (s Scancode) String() string {
+	if name, ok := scancodeNames[s]; ok {
+		return name
+	}
+	return "#" + strconv.FormatUint(uint64(s), 10)
+}
+
+// MarshalText returns the same text as [Scancode.String].
+func (s Scancode) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText accepts the text of [ParseScancode].
+func (s *Scancode) UnmarshalText(text []byte) error {
+	scancode, err := ParseScancode(string(text))
+	if err != nil {
+		return err
+	}
+	*s = scancode
+	return nil
+}
+
+// ParseScancode parses a scancode name as returned by [Scancode.String] (case-insensitive) or a number with
+// a "#" prefix. A number without prefix is the name of a digit key, e.g. "4" is [Scancode4] and not [ScancodeA].
+// Names only known to SDL are resolved with [GetScancodeFromName].
+func ParseScancode(s string) (Scancode, error) {
+	// "#" alone is the name of ScancodeNonUSHash
+	if strings.HasPrefix(s, "#") && isDecimal(s[1:]) {
+		n, err := strconv.ParseUint(s[1:], 10, 32)
+		if err != nil || n >= uint64(ScancodeCount) {
+			return ScancodeUnknown, fmt.Errorf("sdl: invalid scancode number %q", s)
+		}
+		return Scancode(n), nil
+	}
+	if scancode, ok := scancodesByName[strings.ToLower(s)]; ok {
+		return scancode, nil
+	}
+	if scancode := GetScancodeFromName(s); scancode != ScancodeUnknown {
+		return scancode, nil
+	}
+	return ScancodeUnknown, fmt.Errorf("sdl: unknown scancode %q", s)
+}
+
+func isDecimal(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package sdl
+
+import "testing"
+
+func TestScancodeRoundTrip(t *testing.T) {
+	for scancode := ScancodeUnknown; scancode < ScancodeCount; scancode++ {
+		text, err := scancode.MarshalText()
+		if err != nil {
+			t.Fatalf("marshal %d: %v", scancode, err)
+		}
+		parsed, err := ParseScancode(string(text))
+		if err != nil {
+			t.Errorf("parse %q of %d: %v", text, scancode, err)
+		} else if parsed != scancode {
+			t.Errorf("%d was marshalled as %q, which parses as %d", scancode, text, parsed)
+		}
+
+		input := KeyInput(scancode)
+		parsedInput, err := ParseInput(input.String())
+		if err != nil {
+			t.Errorf("parse %q: %v", input, err)
+		} else if parsedInput != input {
+			t.Errorf("%q parses as %+v, want %+v", input, parsedInput, input)
+		}
+	}
+}
+
+func TestScancodeText(t *testing.T) {
+	tests := []struct {
+		text string
+		want Scancode
+	}{
+		{"#0", ScancodeUnknown},
+		{"#4", ScancodeA},
+		{"4", Scancode4},
+		{"left ctrl", ScancodeLCtrl},
+	}
+	for _, test := range tests {
+		if got, err := ParseScancode(test.text); err != nil || got != test.want {
+			t.Errorf("ParseScancode(%q) = %d, %v, want %d", test.text, got, err, test.want)
+		}
+	}
+	if got := ScancodeUnknown.String(); got != "#0" {
+		t.Errorf("ScancodeUnknown.String() = %q, want \"#0\"", got)
+	}
+	if _, err := ParseScancode("#512"); err == nil {
+		t.Error("ParseScancode accepted a number out of range")
+	}
+}
+
+func TestKeycodeRoundTrip(t *testing.T) {
+	for _, key := range []Keycode{KeycodeUnknown, KeycodeA, KeycodePlus, KeycodeF1, KeycodeKpEnter, KeycodeLCtrl, 0x40000123} {
+		parsed, err := ParseKeycode(key.String())
+		if err != nil {
+			t.Errorf("parse %q of %#x: %v", key, uint32(key), err)
+		} else if parsed != key {
+			t.Errorf("%#x was formatted as %q, which parses as %#x", uint32(key), key, uint32(parsed))
+		}
+	}
+
+	for _, chord := range []KeyChord{{KeymodCtrl, KeycodeS}, {KeymodCtrl | KeymodShift, KeycodeA}, {KeymodLAlt, 0x40000123}} {
+		parsed, err := ParseKeyChord(chord.String())
+		if err != nil {
+			t.Errorf("parse %q: %v", chord, err)
+		} else if parsed != chord {
+			t.Errorf("%q parses as %+v, want %+v", chord, parsed, chord)
+		}
+	}
+}
@@ -1,5 +1,17 @@
 package sdl
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/internal/mem"
+)
+
+// Sensor is an opened sensor, see [OpenSensor].
+type Sensor struct{}
+
 type SensorType int32
 
 const (
@@ -13,60 +25,163 @@ const (
 	SensorGyroR
 )
 
-type SensorID uint32
-
-// func CloseSensor(sensor *Sensor)  {
-//	sdlCloseSensor(sensor)
-// }
-
-// func GetSensorData(sensor *Sensor, data *float32, num_values int32) bool {
-//	return sdlGetSensorData(sensor, data, num_values)
-// }
-
-// func GetSensorFromID(instance_id SensorID) *Sensor {
-//	return sdlGetSensorFromID(instance_id)
-// }
-
-// func GetSensorID(sensor *Sensor) SensorID {
-//	return sdlGetSensorID(sensor)
-// }
+// sensorTypeNames are the names of the sensor types, indexed by [SensorType] + 1.
+var sensorTypeNames = [...]string{"invalid", "unknown", "accel", "gyro", "accel_l", "gyro_l", "accel_r", "gyro_r"}
+
+// String returns the name of the sensor type, like "accel" or "gyro_l".
+func (t SensorType) String() string {
+	if i := int(t) + 1; i >= 0 && i < len(sensorTypeNames) {
+		return sensorTypeNames[i]
+	}
+	return strconv.Itoa(int(t))
+}
+
+func (t SensorType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *SensorType) UnmarshalText(text []byte) error {
+	sensorType, err := ParseSensorType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = sensorType
+	return nil
+}
+
+// ParseSensorType parses a sensor type name like "gyro" or "Accel_L", or a type number.
+func ParseSensorType(s string) (SensorType, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for i, typeName := range sensorTypeNames {
+		if name == typeName {
+			return SensorType(i - 1), nil
+		}
+	}
+	if n, err := strconv.ParseInt(name, 10, 32); err == nil && n >= int64(SensorInvalid) {
+		return SensorType(n), nil
+	}
+	return SensorInvalid, fmt.Errorf("sdl: unknown sensor type %q", s)
+}
 
-// func GetSensorName(sensor *Sensor) string {
-//	return sdlGetSensorName(sensor)
-// }
-
-// func GetSensorNameForID(instance_id SensorID) string {
-//	return sdlGetSensorNameForID(instance_id)
-// }
-
-// func GetSensorNonPortableType(sensor *Sensor) int32 {
-//	return sdlGetSensorNonPortableType(sensor)
-// }
-
-// func GetSensorNonPortableTypeForID(instance_id SensorID) int32 {
-//	return sdlGetSensorNonPortableTypeForID(instance_id)
-// }
-
-// func GetSensorProperties(sensor *Sensor) PropertiesID {
-//	return sdlGetSensorProperties(sensor)
-// }
-
-// func GetSensors(count *int32) *SensorID {
-//	return sdlGetSensors(count)
-// }
-
-// func GetSensorType(sensor *Sensor) SensorType {
-//	return sdlGetSensorType(sensor)
-// }
-
-// func GetSensorTypeForID(instance_id SensorID) SensorType {
-//	return sdlGetSensorTypeForID(instance_id)
-// }
-
-// func OpenSensor(instance_id SensorID) *Sensor {
-//	return sdlOpenSensor(instance_id)
-// }
+type SensorID uint32
 
-// func UpdateSensors()  {
-//	sdlUpdateSensors()
-// }
+// StandardGravity is the gravity in m/s² reported by accelerometers of a device at rest.
+const StandardGravity = 9.80665
+
+// [CloseSensor] closes a sensor previously opened with [OpenSensor].
+//
+// [CloseSensor]: https://wiki.libsdl.org/SDL3/SDL_CloseSensor
+func CloseSensor(sensor *Sensor) {
+	sdlCloseSensor(sensor)
+}
+
+// [GetSensorData] gets the current state of an opened sensor.
+//
+// n is the number of values to read, for example 3 for [SensorAccel] and [SensorGyro].
+// It returns nil on failure.
+//
+// [GetSensorData]: https://wiki.libsdl.org/SDL3/SDL_GetSensorData
+func GetSensorData(sensor *Sensor, n int) []float32 {
+	if n <= 0 {
+		return nil
+	}
+	data := make([]float32, n)
+	if !sdlGetSensorData(sensor, &data[0], int32(n)) {
+		return nil
+	}
+	return data
+}
+
+// [GetSensorFromID] returns the [Sensor] associated with an instance ID, or nil if it isn't opened.
+//
+// [GetSensorFromID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorFromID
+func GetSensorFromID(instanceId SensorID) *Sensor {
+	return sdlGetSensorFromID(instanceId)
+}
+
+// [GetSensorID] gets the instance ID of a sensor, or 0 on failure.
+//
+// [GetSensorID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorID
+func GetSensorID(sensor *Sensor) SensorID {
+	return sdlGetSensorID(sensor)
+}
+
+// [GetSensorName] gets the implementation dependent name of a sensor.
+//
+// [GetSensorName]: https://wiki.libsdl.org/SDL3/SDL_GetSensorName
+func GetSensorName(sensor *Sensor) string {
+	return sdlGetSensorName(sensor)
+}
+
+// [GetSensorNameForID] gets the implementation dependent name of a sensor.
+//
+// This can be called before any sensors are opened.
+//
+// [GetSensorNameForID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorNameForID
+func GetSensorNameForID(instanceId SensorID) string {
+	return sdlGetSensorNameForID(instanceId)
+}
+
+// [GetSensorNonPortableType] gets the platform dependent type of a sensor, or -1 if the sensor is invalid.
+//
+// [GetSensorNonPortableType]: https://wiki.libsdl.org/SDL3/SDL_GetSensorNonPortableType
+func GetSensorNonPortableType(sensor *Sensor) int32 {
+	return sdlGetSensorNonPortableType(sensor)
+}
+
+// [GetSensorNonPortableTypeForID] gets the platform dependent type of a sensor, or -1 if the instance ID is invalid.
+//
+// [GetSensorNonPortableTypeForID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorNonPortableTypeForID
+func GetSensorNonPortableTypeForID(instanceId SensorID) int32 {
+	return sdlGetSensorNonPortableTypeForID(instanceId)
+}
+
+// [GetSensorProperties] gets the properties associated with a sensor.
+//
+// [GetSensorProperties]: https://wiki.libsdl.org/SDL3/SDL_GetSensorProperties
+func GetSensorProperties(sensor *Sensor) PropertiesID {
+	return sdlGetSensorProperties(sensor)
+}
+
+// [GetSensors] gets a list of currently connected sensors.
+//
+// [GetSensors]: https://wiki.libsdl.org/SDL3/SDL_GetSensors
+func GetSensors() []SensorID {
+	var count int32
+	sensors := sdlGetSensors(&count)
+	if sensors == nil {
+		return nil
+	}
+	defer Free(unsafe.Pointer(sensors))
+	return mem.Copy(sensors, count)
+}
+
+// [GetSensorType] gets the type of a sensor, or [SensorInvalid] if the sensor is invalid.
+//
+// [GetSensorType]: https://wiki.libsdl.org/SDL3/SDL_GetSensorType
+func GetSensorType(sensor *Sensor) SensorType {
+	return sdlGetSensorType(sensor)
+}
+
+// [GetSensorTypeForID] gets the type of a sensor, or [SensorInvalid] if the instance ID is invalid.
+//
+// [GetSensorTypeForID]: https://wiki.libsdl.org/SDL3/SDL_GetSensorTypeForID
+func GetSensorTypeForID(instanceId SensorID) SensorType {
+	return sdlGetSensorTypeForID(instanceId)
+}
+
+// [OpenSensor] opens a sensor for use.
+//
+// [OpenSensor]: https://wiki.libsdl.org/SDL3/SDL_OpenSensor
+func OpenSensor(instanceId SensorID) *Sensor {
+	return sdlOpenSensor(instanceId)
+}
+
+// [UpdateSensors] updates the current state of the open sensors.
+//
+// This is called automatically by the event loop if sensor events are enabled.
+//
+// [UpdateSensors]: https://wiki.libsdl.org/SDL3/SDL_UpdateSensors
+func UpdateSensors() {
+	sdlUpdateSensors()
+}
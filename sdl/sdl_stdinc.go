@@ -4,10 +4,14 @@ import (
 	"unsafe"
 
 	"github.com/ebitengine/purego"
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
 )
 
 const FltEpsilon = 0x0.000002p0
 
+// Environment is a set of environment variables, see [GetEnvironment] and [CreateEnvironment].
+type Environment struct{}
+
 func FourCC(a, b, c, d byte) uint32 {
 	return uint32(a) | uint32(b)<<8 | uint32(c)<<16 | uint32(d)<<24
 }
@@ -112,13 +116,20 @@ func FourCC(a, b, c, d byte) uint32 {
 //	return sdlcrc32(crc, data, len)
 // }
 
-// func CreateEnvironment(populated bool) *Environment {
-//	return sdlCreateEnvironment(populated)
-// }
+// [CreateEnvironment] creates a set of environment variables. If populated is true, it is initialized
+// with the variables of the process.
+//
+// [CreateEnvironment]: https://wiki.libsdl.org/SDL3/SDL_CreateEnvironment
+func CreateEnvironment(populated bool) *Environment {
+	return sdlCreateEnvironment(populated)
+}
 
-// func DestroyEnvironment(env *Environment)  {
-//	sdlDestroyEnvironment(env)
-// }
+// [DestroyEnvironment] destroys a set of environment variables.
+//
+// [DestroyEnvironment]: https://wiki.libsdl.org/SDL3/SDL_DestroyEnvironment
+func DestroyEnvironment(env *Environment) {
+	sdlDestroyEnvironment(env)
+}
 
 // func exp(x float64) float64 {
 //	return sdlexp(x)
@@ -167,17 +178,31 @@ func Free(mem unsafe.Pointer) {
 //	return sdlgetenv_unsafe(name)
 // }
 
-// func GetEnvironment() *Environment {
-//	return sdlGetEnvironment()
-// }
+// [GetEnvironment] gets the process environment. It is owned by SDL and must not be destroyed.
+//
+// [GetEnvironment]: https://wiki.libsdl.org/SDL3/SDL_GetEnvironment
+func GetEnvironment() *Environment {
+	return sdlGetEnvironment()
+}
 
-// func GetEnvironmentVariable(env *Environment, name string) string {
-//	return sdlGetEnvironmentVariable(env, name)
-// }
+// [GetEnvironmentVariable] gets the value of a variable in the environment, or an empty string if it isn't set.
+//
+// [GetEnvironmentVariable]: https://wiki.libsdl.org/SDL3/SDL_GetEnvironmentVariable
+func GetEnvironmentVariable(env *Environment, name string) string {
+	return sdlGetEnvironmentVariable(env, name)
+}
 
-// func GetEnvironmentVariables(env *Environment) **byte {
-//	return sdlGetEnvironmentVariables(env)
-// }
+// [GetEnvironmentVariables] gets all variables in the environment in the form "name=value".
+//
+// [GetEnvironmentVariables]: https://wiki.libsdl.org/SDL3/SDL_GetEnvironmentVariables
+func GetEnvironmentVariables(env *Environment) []string {
+	variables := sdlGetEnvironmentVariables(env)
+	if variables == nil {
+		return nil
+	}
+	defer Free(unsafe.Pointer(variables))
+	return convert.ToStringSlice(variables)
+}
 
 // func GetMemoryFunctions(malloc_func *malloc_func, calloc_func *calloc_func, realloc_func *realloc_func, free_func *free_func)  {
 //	sdlGetMemoryFunctions(malloc_func, calloc_func, realloc_func, free_func)
@@ -307,9 +332,12 @@ func Free(mem unsafe.Pointer) {
 //	return sdlltoa(value, str, radix)
 // }
 
-// func malloc(size uint64) unsafe.Pointer {
-//	return sdlmalloc(size)
-// }
+// [Malloc] allocates uninitialized memory, which has to be freed with [Free].
+//
+// [Malloc]: https://wiki.libsdl.org/SDL3/SDL_malloc
+func Malloc(size uint64) unsafe.Pointer {
+	return sdlmalloc(size)
+}
 
 // func memcmp(s1 unsafe.Pointer, s2 unsafe.Pointer, len uint64) int32 {
 //	return sdlmemcmp(s1, s2, len)
@@ -407,9 +435,13 @@ func Free(mem unsafe.Pointer) {
 //	return sdlsetenv_unsafe(name, value, overwrite)
 // }
 
-// func SetEnvironmentVariable(env *Environment, name string, value string, overwrite bool) bool {
-//	return sdlSetEnvironmentVariable(env, name, value, overwrite)
-// }
+// [SetEnvironmentVariable] sets the value of a variable in the environment.
+// If overwrite is false, an existing value is kept.
+//
+// [SetEnvironmentVariable]: https://wiki.libsdl.org/SDL3/SDL_SetEnvironmentVariable
+func SetEnvironmentVariable(env *Environment, name string, value string, overwrite bool) bool {
+	return sdlSetEnvironmentVariable(env, name, value, overwrite)
+}
 
 // func SetMemoryFunctions(malloc_func malloc_func, calloc_func calloc_func, realloc_func realloc_func, free_func free_func) bool {
 //	return sdlSetMemoryFunctions(malloc_func, calloc_func, realloc_func, free_func)
@@ -615,9 +647,12 @@ func Strstr(haystack string, needle string) string {
 //	return sdlunsetenv_unsafe(name)
 // }
 
-// func UnsetEnvironmentVariable(env *Environment, name string) bool {
-//	return sdlUnsetEnvironmentVariable(env, name)
-// }
+// [UnsetEnvironmentVariable] clears a variable from the environment.
+//
+// [UnsetEnvironmentVariable]: https://wiki.libsdl.org/SDL3/SDL_UnsetEnvironmentVariable
+func UnsetEnvironmentVariable(env *Environment, name string) bool {
+	return sdlUnsetEnvironmentVariable(env, name)
+}
 
 // func utf8strlcpy(dst string, src string, dst_bytes uint64) uint64 {
 //	return sdlutf8strlcpy(dst, src, dst_bytes)
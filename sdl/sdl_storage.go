@@ -1,69 +1,338 @@
 package sdl
 
-// func CloseStorage(storage *Storage) bool {
-//	return sdlCloseStorage(storage)
-// }
+import (
+	"runtime"
+	"sync"
+	"unsafe"
 
-// func CopyStorageFile(storage *Storage, oldpath string, newpath string) bool {
-//	return sdlCopyStorageFile(storage, oldpath, newpath)
-// }
+	"github.com/ebitengine/purego"
+	"github.com/jupiterrider/purego-sdl3/internal/convert"
+)
 
-// func CreateStorageDirectory(storage *Storage, path string) bool {
-//	return sdlCreateStorageDirectory(storage, path)
-// }
+// Storage is an abstract interface for filesystem access, like the read-only game data of a title
+// or the save data of a user. See [StorageFS] to use it with package io/fs.
+type Storage struct{}
 
-// func EnumerateStorageDirectory(storage *Storage, path string, callback EnumerateDirectoryCallback, userdata unsafe.Pointer) bool {
-//	return sdlEnumerateStorageDirectory(storage, path, callback, userdata)
-// }
+// [CloseStorage] closes and frees a storage container.
+//
+// [CloseStorage]: https://wiki.libsdl.org/SDL3/SDL_CloseStorage
+func CloseStorage(storage *Storage) bool {
+	return sdlCloseStorage(storage)
+}
 
-// func GetStorageFileSize(storage *Storage, path string, length *uint64) bool {
-//	return sdlGetStorageFileSize(storage, path, length)
-// }
+// [CopyStorageFile] copies a file in a writable storage container.
+//
+// [CopyStorageFile]: https://wiki.libsdl.org/SDL3/SDL_CopyStorageFile
+func CopyStorageFile(storage *Storage, oldpath string, newpath string) bool {
+	return sdlCopyStorageFile(storage, oldpath, newpath)
+}
 
-// func GetStoragePathInfo(storage *Storage, path string, info *PathInfo) bool {
-//	return sdlGetStoragePathInfo(storage, path, info)
-// }
+// [CreateStorageDirectory] creates a directory in a writable storage container.
+//
+// [CreateStorageDirectory]: https://wiki.libsdl.org/SDL3/SDL_CreateStorageDirectory
+func CreateStorageDirectory(storage *Storage, path string) bool {
+	return sdlCreateStorageDirectory(storage, path)
+}
 
-// func GetStorageSpaceRemaining(storage *Storage) uint64 {
-//	return sdlGetStorageSpaceRemaining(storage)
-// }
+// [EnumerateStorageDirectory] enumerates a directory in a storage container through a callback function.
+// An empty path enumerates the root. The callback is only called during this function.
+//
+// [EnumerateStorageDirectory]: https://wiki.libsdl.org/SDL3/SDL_EnumerateStorageDirectory
+func EnumerateStorageDirectory(storage *Storage, path string, callback EnumerateDirectoryCallback) bool {
+	return enumerate(callback, func(fn, userdata uintptr) bool {
+		return sdlEnumerateStorageDirectory(storage, convert.ToBytePtrNullable(path), fn, userdata)
+	})
+}
 
-// func GlobStorageDirectory(storage *Storage, path string, pattern string, flags GlobFlags, count *int32) **byte {
-//	return sdlGlobStorageDirectory(storage, path, pattern, flags, count)
-// }
+// [GetStorageFileSize] queries the size of a file within a storage container.
+//
+// [GetStorageFileSize]: https://wiki.libsdl.org/SDL3/SDL_GetStorageFileSize
+func GetStorageFileSize(storage *Storage, path string, length *uint64) bool {
+	return sdlGetStorageFileSize(storage, path, length)
+}
 
-// func OpenFileStorage(path string) *Storage {
-//	return sdlOpenFileStorage(path)
-// }
+// [GetStoragePathInfo] gets information about a filesystem path in a storage container.
+// info may be nil to only check whether the path exists.
+//
+// [GetStoragePathInfo]: https://wiki.libsdl.org/SDL3/SDL_GetStoragePathInfo
+func GetStoragePathInfo(storage *Storage, path string, info *PathInfo) bool {
+	var cInfo pathInfo
+	if !sdlGetStoragePathInfo(storage, path, &cInfo) {
+		return false
+	}
+	if info != nil {
+		*info = cInfo.toGo()
+	}
+	return true
+}
 
-// func OpenStorage(iface *StorageInterface, userdata unsafe.Pointer) *Storage {
-//	return sdlOpenStorage(iface, userdata)
-// }
+// [GetStorageSpaceRemaining] queries the remaining space in a storage container.
+//
+// [GetStorageSpaceRemaining]: https://wiki.libsdl.org/SDL3/SDL_GetStorageSpaceRemaining
+func GetStorageSpaceRemaining(storage *Storage) uint64 {
+	return sdlGetStorageSpaceRemaining(storage)
+}
 
-// func OpenTitleStorage(override string, props PropertiesID) *Storage {
-//	return sdlOpenTitleStorage(override, props)
-// }
+// [GlobStorageDirectory] enumerates a directory tree, filtered by pattern, and returns the matching paths.
+// An empty path starts at the root and an empty pattern matches everything. It returns nil on failure.
+//
+// [GlobStorageDirectory]: https://wiki.libsdl.org/SDL3/SDL_GlobStorageDirectory
+func GlobStorageDirectory(storage *Storage, path string, pattern string, flags GlobFlags) []string {
+	var count int32
+	paths := sdlGlobStorageDirectory(storage, convert.ToBytePtrNullable(path), convert.ToBytePtrNullable(pattern), flags, &count)
+	return globResult(paths, count)
+}
 
-// func OpenUserStorage(org string, app string, props PropertiesID) *Storage {
-//	return sdlOpenUserStorage(org, app, props)
-// }
+// [OpenFileStorage] opens up a container for local filesystem storage.
+//
+// [OpenFileStorage]: https://wiki.libsdl.org/SDL3/SDL_OpenFileStorage
+func OpenFileStorage(path string) *Storage {
+	return sdlOpenFileStorage(path)
+}
 
-// func ReadStorageFile(storage *Storage, path string, destination unsafe.Pointer, length uint64) bool {
-//	return sdlReadStorageFile(storage, path, destination, length)
-// }
+// StorageInterface implements a [Storage] in Go, see [OpenStorage]. Functions that are nil are reported
+// as unsupported by SDL, except Ready, which defaults to ready. To report why an operation failed, use [SetError].
+//
+// Paths are relative to the root of the storage and use "/" as separator.
+type StorageInterface struct {
+	// Close is called once when the storage is closed and reports whether that succeeded.
+	Close func() bool
+	// Ready reports whether the storage is ready to use.
+	Ready func() bool
+	// Enumerate calls callback for each entry of the directory path, until it returns something
+	// else than [EnumContinue]. It returns false if the directory can't be read or the callback returned [EnumFailure].
+	Enumerate func(path string, callback EnumerateDirectoryCallback) bool
+	// Info returns information about path, or false if it doesn't exist.
+	Info func(path string) (PathInfo, bool)
+	// ReadFile reads the file path into dst, which has the size of the file.
+	ReadFile func(path string, dst []byte) bool
+	// WriteFile creates or replaces the file path with src.
+	WriteFile func(path string, src []byte) bool
+	// Mkdir creates the directory path.
+	Mkdir func(path string) bool
+	// Remove removes the file or empty directory path.
+	Remove func(path string) bool
+	// Rename renames or moves oldpath to newpath.
+	Rename func(oldpath, newpath string) bool
+	// Copy copies the file oldpath to newpath.
+	Copy func(oldpath, newpath string) bool
+	// SpaceRemaining returns the number of bytes that can still be written.
+	SpaceRemaining func() uint64
+}
 
-// func RemoveStoragePath(storage *Storage, path string) bool {
-//	return sdlRemoveStoragePath(storage, path)
-// }
+// storageInterface is the C layout of SDL_StorageInterface.
+type storageInterface struct {
+	version        uint32
+	close          uintptr
+	ready          uintptr
+	enumerate      uintptr
+	info           uintptr
+	readFile       uintptr
+	writeFile      uintptr
+	mkdir          uintptr
+	remove         uintptr
+	rename         uintptr
+	copy           uintptr
+	spaceRemaining uintptr
+}
 
-// func RenameStoragePath(storage *Storage, oldpath string, newpath string) bool {
-//	return sdlRenameStoragePath(storage, oldpath, newpath)
-// }
+// storages maps the userdata of opened storages to their interfaces.
+var storages = struct {
+	sync.Mutex
+	next   uintptr
+	ifaces map[uintptr]*StorageInterface
+}{ifaces: make(map[uintptr]*StorageInterface)}
 
-// func StorageReady(storage *Storage) bool {
-//	return sdlStorageReady(storage)
-// }
+func lookupStorage(userdata uintptr) *StorageInterface {
+	storages.Lock()
+	defer storages.Unlock()
+	return storages.ifaces[userdata]
+}
 
-// func WriteStorageFile(storage *Storage, path string, source unsafe.Pointer, length uint64) bool {
-//	return sdlWriteStorageFile(storage, path, source, length)
-// }
+var storageCallbacks struct {
+	once                                               sync.Once
+	close, ready, enumerate, info, readFile, writeFile uintptr
+	mkdir, remove, rename, copy, spaceRemaining        uintptr
+}
+
+func initStorageCallbacks() {
+	cb := &storageCallbacks
+	cb.close = purego.NewCallback(func(userdata uintptr) uintptr {
+		storages.Lock()
+		iface := storages.ifaces[userdata]
+		delete(storages.ifaces, userdata)
+		storages.Unlock()
+		if iface == nil || iface.Close == nil {
+			return 1
+		}
+		return boolToUintptr(iface.Close())
+	})
+	cb.ready = purego.NewCallback(func(userdata uintptr) uintptr {
+		iface := lookupStorage(userdata)
+		return boolToUintptr(iface == nil || iface.Ready == nil || iface.Ready())
+	})
+	cb.enumerate = purego.NewCallback(func(userdata uintptr, path *byte, callback, callbackUserdata uintptr) uintptr {
+		iface := lookupStorage(userdata)
+		if iface == nil || iface.Enumerate == nil {
+			return 0
+		}
+		return boolToUintptr(iface.Enumerate(convert.ToString(path), func(dirname, fname string) EnumerationResult {
+			cDirname, cFname := convert.ToBytePtr(dirname), convert.ToBytePtr(fname)
+			ret, _, _ := purego.SyscallN(callback, callbackUserdata, uintptr(unsafe.Pointer(cDirname)), uintptr(unsafe.Pointer(cFname)))
+			runtime.KeepAlive(cDirname)
+			runtime.KeepAlive(cFname)
+			return EnumerationResult(uint32(ret))
+		}))
+	})
+	cb.info = purego.NewCallback(func(userdata uintptr, path *byte, info *pathInfo) uintptr {
+		iface := lookupStorage(userdata)
+		if iface == nil || iface.Info == nil {
+			return 0
+		}
+		goInfo, ok := iface.Info(convert.ToString(path))
+		if ok && info != nil {
+			info.fromGo(goInfo)
+		}
+		return boolToUintptr(ok)
+	})
+	cb.readFile = purego.NewCallback(func(userdata uintptr, path *byte, dst *byte, length uint64) uintptr {
+		iface := lookupStorage(userdata)
+		if iface == nil || iface.ReadFile == nil {
+			return 0
+		}
+		return boolToUintptr(iface.ReadFile(convert.ToString(path), unsafe.Slice(dst, length)))
+	})
+	cb.writeFile = purego.NewCallback(func(userdata uintptr, path *byte, src *byte, length uint64) uintptr {
+		iface := lookupStorage(userdata)
+		if iface == nil || iface.WriteFile == nil {
+			return 0
+		}
+		return boolToUintptr(iface.WriteFile(convert.ToString(path), unsafe.Slice(src, length)))
+	})
+	cb.mkdir = purego.NewCallback(func(userdata uintptr, path *byte) uintptr {
+		iface := lookupStorage(userdata)
+		return boolToUintptr(iface != nil && iface.Mkdir != nil && iface.Mkdir(convert.ToString(path)))
+	})
+	cb.remove = purego.NewCallback(func(userdata uintptr, path *byte) uintptr {
+		iface := lookupStorage(userdata)
+		return boolToUintptr(iface != nil && iface.Remove != nil && iface.Remove(convert.ToString(path)))
+	})
+	cb.rename = purego.NewCallback(func(userdata uintptr, oldpath, newpath *byte) uintptr {
+		iface := lookupStorage(userdata)
+		return boolToUintptr(iface != nil && iface.Rename != nil && iface.Rename(convert.ToString(oldpath), convert.ToString(newpath)))
+	})
+	cb.copy = purego.NewCallback(func(userdata uintptr, oldpath, newpath *byte) uintptr {
+		iface := lookupStorage(userdata)
+		return boolToUintptr(iface != nil && iface.Copy != nil && iface.Copy(convert.ToString(oldpath), convert.ToString(newpath)))
+	})
+	cb.spaceRemaining = purego.NewCallback(func(userdata uintptr) uint64 {
+		if iface := lookupStorage(userdata); iface != nil && iface.SpaceRemaining != nil {
+			return iface.SpaceRemaining()
+		}
+		return 0
+	})
+}
+
+// [OpenStorage] opens up a container using a client-provided storage interface.
+//
+// The functions of iface are called from the thread that uses the storage. They stay registered until
+// [CloseStorage] is called. See [StorageFromFS] for a storage backed by an [fs.FS].
+//
+// [OpenStorage]: https://wiki.libsdl.org/SDL3/SDL_OpenStorage
+func OpenStorage(iface StorageInterface) *Storage {
+	callbacks := &storageCallbacks
+	callbacks.once.Do(initStorageCallbacks)
+
+	storages.Lock()
+	storages.next++
+	userdata := storages.next
+	storages.ifaces[userdata] = &iface
+	storages.Unlock()
+
+	// SDL copies the interface and checks each function for NULL to report unsupported operations
+	cIface := storageInterface{
+		close: callbacks.close,
+		ready: callbacks.ready,
+	}
+	set := func(fn uintptr, ok bool) uintptr {
+		if ok {
+			return fn
+		}
+		return 0
+	}
+	cIface.enumerate = set(callbacks.enumerate, iface.Enumerate != nil)
+	cIface.info = set(callbacks.info, iface.Info != nil)
+	cIface.readFile = set(callbacks.readFile, iface.ReadFile != nil)
+	cIface.writeFile = set(callbacks.writeFile, iface.WriteFile != nil)
+	cIface.mkdir = set(callbacks.mkdir, iface.Mkdir != nil)
+	cIface.remove = set(callbacks.remove, iface.Remove != nil)
+	cIface.rename = set(callbacks.rename, iface.Rename != nil)
+	cIface.copy = set(callbacks.copy, iface.Copy != nil)
+	cIface.spaceRemaining = set(callbacks.spaceRemaining, iface.SpaceRemaining != nil)
+	cIface.version = uint32(unsafe.Sizeof(cIface))
+
+	storage := sdlOpenStorage(&cIface, userdata)
+	if storage == nil {
+		storages.Lock()
+		delete(storages.ifaces, userdata)
+		storages.Unlock()
+	}
+	return storage
+}
+
+// [OpenTitleStorage] opens up a read-only container for the application's filesystem.
+// An empty override uses the default location.
+//
+// [OpenTitleStorage]: https://wiki.libsdl.org/SDL3/SDL_OpenTitleStorage
+func OpenTitleStorage(override string, props PropertiesID) *Storage {
+	return sdlOpenTitleStorage(convert.ToBytePtrNullable(override), props)
+}
+
+// [OpenUserStorage] opens up a container for a user's unique read/write filesystem.
+//
+// [OpenUserStorage]: https://wiki.libsdl.org/SDL3/SDL_OpenUserStorage
+func OpenUserStorage(org string, app string, props PropertiesID) *Storage {
+	return sdlOpenUserStorage(org, app, props)
+}
+
+// [ReadStorageFile] synchronously reads a file from a storage container into destination.
+// The length of destination must match the size of the file, see [GetStorageFileSize].
+//
+// [ReadStorageFile]: https://wiki.libsdl.org/SDL3/SDL_ReadStorageFile
+func ReadStorageFile(storage *Storage, path string, destination []byte) bool {
+	if len(destination) == 0 {
+		return sdlReadStorageFile(storage, path, nil, 0)
+	}
+	return sdlReadStorageFile(storage, path, unsafe.Pointer(&destination[0]), uint64(len(destination)))
+}
+
+// [RemoveStoragePath] removes a file or an empty directory in a writable storage container.
+//
+// [RemoveStoragePath]: https://wiki.libsdl.org/SDL3/SDL_RemoveStoragePath
+func RemoveStoragePath(storage *Storage, path string) bool {
+	return sdlRemoveStoragePath(storage, path)
+}
+
+// [RenameStoragePath] renames a file or directory in a writable storage container.
+//
+// [RenameStoragePath]: https://wiki.libsdl.org/SDL3/SDL_RenameStoragePath
+func RenameStoragePath(storage *Storage, oldpath string, newpath string) bool {
+	return sdlRenameStoragePath(storage, oldpath, newpath)
+}
+
+// [StorageReady] checks if the storage container is ready to use.
+//
+// [StorageReady]: https://wiki.libsdl.org/SDL3/SDL_StorageReady
+func StorageReady(storage *Storage) bool {
+	return sdlStorageReady(storage)
+}
+
+// [WriteStorageFile] synchronously writes source to a file in a writable storage container.
+//
+// [WriteStorageFile]: https://wiki.libsdl.org/SDL3/SDL_WriteStorageFile
+func WriteStorageFile(storage *Storage, path string, source []byte) bool {
+	if len(source) == 0 {
+		return sdlWriteStorageFile(storage, path, nil, 0)
+	}
+	return sdlWriteStorageFile(storage, path, unsafe.Pointer(&source[0]), uint64(len(source)))
+}
@@ -0,0 +1,504 @@
+package sdl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultStorageTimeout is the time a new [StorageFS] waits for its storage to become ready.
+const DefaultStorageTimeout = 10 * time.Second
+
+// ErrStorageNotReady is returned when a storage container didn't become ready in time.
+var ErrStorageNotReady = errors.New("sdl: storage is not ready")
+
+// WaitStorageReady polls [StorageReady] until the storage is ready or the timeout expires.
+// A timeout <= 0 checks only once.
+func WaitStorageReady(storage *Storage, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for !StorageReady(storage) {
+		if !time.Now().Before(deadline) {
+			return ErrStorageNotReady
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return nil
+}
+
+// WriteFS is a file system that can be modified, like a [StorageFS] of a user storage.
+// Names are slash-separated paths as in [fs.FS].
+type WriteFS interface {
+	fs.FS
+	// WriteFile creates or replaces the file name with data.
+	WriteFile(name string, data []byte) error
+	// Mkdir creates the directory name.
+	Mkdir(name string) error
+	// Remove removes the file or empty directory name.
+	Remove(name string) error
+	// Rename renames or moves oldname to newname.
+	Rename(oldname, newname string) error
+}
+
+// StorageFS makes a [Storage] usable with package io/fs. It implements [fs.FS], [fs.ReadDirFS], [fs.StatFS],
+// [fs.GlobFS], [fs.ReadFileFS] and [WriteFS].
+//
+// Storage has no streaming access, so opening a file reads all of it into memory.
+// Before each operation the file system waits up to Timeout for the storage to become ready,
+// and fails with [ErrStorageNotReady] afterwards.
+//
+// Example:
+//
+//	saves := sdl.NewStorageFS(sdl.OpenUserStorage("mygame", "mygame", 0))
+//	defer saves.Close()
+//	data, err := fs.ReadFile(saves, "slot1.sav")
+//	err = saves.WriteFile("slot1.sav", data)
+type StorageFS struct {
+	Timeout time.Duration
+	storage *Storage
+}
+
+// NewStorageFS creates a file system for storage with [DefaultStorageTimeout].
+// Closing the file system closes the storage.
+func NewStorageFS(storage *Storage) *StorageFS {
+	return &StorageFS{Timeout: DefaultStorageTimeout, storage: storage}
+}
+
+// Storage returns the underlying storage container.
+func (s *StorageFS) Storage() *Storage {
+	return s.storage
+}
+
+// Close closes the storage container. Pending writes are flushed by SDL.
+func (s *StorageFS) Close() error {
+	if s.storage == nil {
+		return fs.ErrClosed
+	}
+	storage := s.storage
+	s.storage = nil
+	if !CloseStorage(storage) {
+		return fmt.Errorf("sdl: close storage: %s", GetError())
+	}
+	return nil
+}
+
+// SpaceRemaining returns the number of bytes that can still be written.
+func (s *StorageFS) SpaceRemaining() uint64 {
+	if s.storage == nil {
+		return 0
+	}
+	return GetStorageSpaceRemaining(s.storage)
+}
+
+// prepare validates name, waits for the storage and returns the path for SDL, which is empty for the root.
+func (s *StorageFS) prepare(op, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	if s.storage == nil {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrClosed}
+	}
+	if err := WaitStorageReady(s.storage, s.Timeout); err != nil {
+		return "", &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	if name == "." {
+		return "", nil
+	}
+	return name, nil
+}
+
+func storageError(op, name string) error {
+	if msg := GetError(); msg != "" {
+		return &fs.PathError{Op: op, Path: name, Err: errors.New(msg)}
+	}
+	return &fs.PathError{Op: op, Path: name, Err: errors.New("sdl: storage operation failed")}
+}
+
+func (s *StorageFS) stat(op, name, p string) (*storageFileInfo, error) {
+	if p == "" {
+		return &storageFileInfo{name: ".", info: PathInfo{Type: PathTypeDirectory}}, nil
+	}
+	var info PathInfo
+	if !GetStoragePathInfo(s.storage, p, &info) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+	return &storageFileInfo{name: path.Base(name), info: info}, nil
+}
+
+// Stat implements [fs.StatFS].
+func (s *StorageFS) Stat(name string) (fs.FileInfo, error) {
+	p, err := s.prepare("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.stat("stat", name, p)
+}
+
+// Open implements [fs.FS]. Files are read completely, directories are listed when they are opened.
+func (s *StorageFS) Open(name string) (fs.File, error) {
+	p, err := s.prepare("open", name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.stat("open", name, p)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		entries, err := s.readDir("open", name, p)
+		if err != nil {
+			return nil, err
+		}
+		return &storageDir{info: info, entries: entries}, nil
+	}
+	data, err := s.readFile("open", name, p, info)
+	if err != nil {
+		return nil, err
+	}
+	return &storageFile{info: info, Reader: bytes.NewReader(data)}, nil
+}
+
+// ReadFile implements [fs.ReadFileFS].
+func (s *StorageFS) ReadFile(name string) ([]byte, error) {
+	p, err := s.prepare("read", name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.stat("read", name, p)
+	if err != nil {
+		return nil, err
+	}
+	return s.readFile("read", name, p, info)
+}
+
+func (s *StorageFS) readFile(op, name, p string, info *storageFileInfo) ([]byte, error) {
+	if info.IsDir() {
+		return nil, &fs.PathError{Op: op, Path: name, Err: errors.New("is a directory")}
+	}
+	data := make([]byte, info.info.Size)
+	if !ReadStorageFile(s.storage, p, data) {
+		return nil, storageError(op, name)
+	}
+	return data, nil
+}
+
+// ReadDir implements [fs.ReadDirFS]. The entries are sorted by name.
+func (s *StorageFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	p, err := s.prepare("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.readDir("readdir", name, p)
+}
+
+func (s *StorageFS) readDir(op, name, p string) ([]fs.DirEntry, error) {
+	var entries []fs.DirEntry
+	var statErr error
+	ok := EnumerateStorageDirectory(s.storage, p, func(dirname, fname string) EnumerationResult {
+		var info PathInfo
+		if !GetStoragePathInfo(s.storage, path.Join(p, fname), &info) {
+			statErr = storageError(op, path.Join(name, fname))
+			return EnumFailure
+		}
+		entries = append(entries, &storageFileInfo{name: fname, info: info})
+		return EnumContinue
+	})
+	if statErr != nil {
+		return nil, statErr
+	}
+	if !ok {
+		return nil, storageError(op, name)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+// Glob implements [fs.GlobFS] with the syntax of [path.Match]. Patterns that SDL understands
+// are matched by SDL, others fall back to [fs.Glob].
+func (s *StorageFS) Glob(pattern string) ([]string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(pattern, `[\`) {
+		// hide the Glob method, so that fs.Glob doesn't call it again
+		return fs.Glob(struct{ fs.ReadDirFS }{s}, pattern)
+	}
+	if _, err := s.prepare("glob", "."); err != nil {
+		return nil, err
+	}
+	paths := GlobStorageDirectory(s.storage, "", pattern, 0)
+	if paths == nil {
+		return nil, storageError("glob", pattern)
+	}
+	// the wildcards of SDL may match across directories, path.Match doesn't
+	matches := paths[:0]
+	for _, p := range paths {
+		if ok, _ := path.Match(pattern, p); ok {
+			matches = append(matches, p)
+		}
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// WriteFile implements [WriteFS].
+func (s *StorageFS) WriteFile(name string, data []byte) error {
+	p, err := s.prepare("write", name)
+	if err != nil {
+		return err
+	}
+	if !WriteStorageFile(s.storage, p, data) {
+		return storageError("write", name)
+	}
+	return nil
+}
+
+// Mkdir implements [WriteFS].
+func (s *StorageFS) Mkdir(name string) error {
+	p, err := s.prepare("mkdir", name)
+	if err != nil {
+		return err
+	}
+	if !CreateStorageDirectory(s.storage, p) {
+		return storageError("mkdir", name)
+	}
+	return nil
+}
+
+// Remove implements [WriteFS].
+func (s *StorageFS) Remove(name string) error {
+	p, err := s.prepare("remove", name)
+	if err != nil {
+		return err
+	}
+	if !RemoveStoragePath(s.storage, p) {
+		return storageError("remove", name)
+	}
+	return nil
+}
+
+// Rename implements [WriteFS].
+func (s *StorageFS) Rename(oldname, newname string) error {
+	oldpath, err := s.prepare("rename", oldname)
+	if err != nil {
+		return err
+	}
+	if !fs.ValidPath(newname) {
+		return &fs.PathError{Op: "rename", Path: newname, Err: fs.ErrInvalid}
+	}
+	if !RenameStoragePath(s.storage, oldpath, newname) {
+		return storageError("rename", oldname)
+	}
+	return nil
+}
+
+// Copy copies the file oldname to newname.
+func (s *StorageFS) Copy(oldname, newname string) error {
+	oldpath, err := s.prepare("copy", oldname)
+	if err != nil {
+		return err
+	}
+	if !fs.ValidPath(newname) {
+		return &fs.PathError{Op: "copy", Path: newname, Err: fs.ErrInvalid}
+	}
+	if !CopyStorageFile(s.storage, oldpath, newname) {
+		return storageError("copy", oldname)
+	}
+	return nil
+}
+
+// storageFileInfo implements [fs.FileInfo] and [fs.DirEntry].
+type storageFileInfo struct {
+	name string
+	info PathInfo
+}
+
+func (i *storageFileInfo) Name() string { return i.name }
+
+func (i *storageFileInfo) Size() int64 { return int64(i.info.Size) }
+
+func (i *storageFileInfo) Mode() fs.FileMode {
+	switch i.info.Type {
+	case PathTypeDirectory:
+		return fs.ModeDir | 0o555
+	case PathTypeFile:
+		return 0o444
+	}
+	return fs.ModeIrregular | 0o444
+}
+
+func (i *storageFileInfo) ModTime() time.Time { return i.info.ModifyTime }
+
+func (i *storageFileInfo) IsDir() bool { return i.info.Type == PathTypeDirectory }
+
+// Sys returns the [PathInfo].
+func (i *storageFileInfo) Sys() any { return i.info }
+
+func (i *storageFileInfo) Type() fs.FileMode { return i.Mode().Type() }
+
+func (i *storageFileInfo) Info() (fs.FileInfo, error) { return i, nil }
+
+// storageFile is an opened file, which was read into memory.
+type storageFile struct {
+	*bytes.Reader
+	info *storageFileInfo
+}
+
+func (f *storageFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+
+func (f *storageFile) Close() error { return nil }
+
+// storageDir is an opened directory.
+type storageDir struct {
+	info    *storageFileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *storageDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+
+func (d *storageDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: errors.New("is a directory")}
+}
+
+func (d *storageDir) Close() error { return nil }
+
+// ReadDir implements [fs.ReadDirFile].
+func (d *storageDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	rest := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(rest) {
+		n = len(rest)
+	}
+	d.offset += n
+	return rest[:n], nil
+}
+
+// StorageFromFS creates a [Storage] that is backed by fsys, like an [embed.FS], a [zip.Reader] or an
+// in-memory file system in tests. If fsys implements [WriteFS], the storage is writable.
+// If fsys implements [io.Closer], it is closed together with the storage.
+//
+// Example:
+//
+//	//go:embed assets
+//	var assets embed.FS
+//
+//	storage := sdl.StorageFromFS(assets)
+//	defer sdl.CloseStorage(storage)
+func StorageFromFS(fsys fs.FS) *Storage {
+	iface := StorageInterface{
+		Enumerate: func(p string, callback EnumerateDirectoryCallback) bool {
+			name := fsName(p)
+			entries, err := fs.ReadDir(fsys, name)
+			if err != nil {
+				setIOError(err)
+				return false
+			}
+			dirname := ""
+			if name != "." {
+				dirname = name + "/"
+			}
+			for _, entry := range entries {
+				switch callback(dirname, entry.Name()) {
+				case EnumSuccess:
+					return true
+				case EnumFailure:
+					return false
+				}
+			}
+			return true
+		},
+		Info: func(p string) (PathInfo, bool) {
+			info, err := fs.Stat(fsys, fsName(p))
+			if err != nil {
+				setIOError(err)
+				return PathInfo{}, false
+			}
+			pathInfo := PathInfo{Type: PathTypeOther, Size: uint64(info.Size()), ModifyTime: info.ModTime()}
+			switch {
+			case info.IsDir():
+				pathInfo.Type = PathTypeDirectory
+				pathInfo.Size = 0
+			case info.Mode().IsRegular():
+				pathInfo.Type = PathTypeFile
+			}
+			return pathInfo, true
+		},
+		ReadFile: func(p string, dst []byte) bool {
+			data, err := fs.ReadFile(fsys, fsName(p))
+			if err != nil {
+				setIOError(err)
+				return false
+			}
+			if len(data) != len(dst) {
+				SetError("%s", "file size changed")
+				return false
+			}
+			copy(dst, data)
+			return true
+		},
+		Close: func() bool {
+			if closer, ok := fsys.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					setIOError(err)
+					return false
+				}
+			}
+			return true
+		},
+	}
+
+	if wfs, ok := fsys.(WriteFS); ok {
+		iface.WriteFile = func(p string, src []byte) bool {
+			// SDL owns src, so the file system must not keep it
+			return fsResult(wfs.WriteFile(fsName(p), append([]byte(nil), src...)))
+		}
+		iface.Mkdir = func(p string) bool {
+			return fsResult(wfs.Mkdir(fsName(p)))
+		}
+		iface.Remove = func(p string) bool {
+			return fsResult(wfs.Remove(fsName(p)))
+		}
+		iface.Rename = func(oldpath, newpath string) bool {
+			return fsResult(wfs.Rename(fsName(oldpath), fsName(newpath)))
+		}
+		iface.Copy = func(oldpath, newpath string) bool {
+			data, err := fs.ReadFile(wfs, fsName(oldpath))
+			if err != nil {
+				return fsResult(err)
+			}
+			return fsResult(wfs.WriteFile(fsName(newpath), data))
+		}
+	}
+	return OpenStorage(iface)
+}
+
+// fsName converts a storage path to a name for package io/fs.
+func fsName(p string) string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return "."
+	}
+	return p
+}
+
+func fsResult(err error) bool {
+	if err != nil {
+		setIOError(err)
+		return false
+	}
+	return true
+}
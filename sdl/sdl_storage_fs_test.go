@@ -0,0 +1,108 @@
+package sdl
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// fsStorage wraps fsys in a [StorageFS]. The test is skipped if SDL can't open the storage, e.g. without a real SDL library.
+func fsStorage(t *testing.T, fsys fs.FS) *StorageFS {
+	t.Helper()
+	storage := StorageFromFS(fsys)
+	if storage == nil {
+		t.Skipf("SDL can't open a storage: %s", GetError())
+	}
+	sfs := NewStorageFS(storage)
+	t.Cleanup(func() { sfs.Close() })
+	return sfs
+}
+
+var storageTestTime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// memWriteFS is a writable in-memory file system.
+type memWriteFS struct {
+	fstest.MapFS
+}
+
+func (m memWriteFS) WriteFile(name string, data []byte) error {
+	m.MapFS[name] = &fstest.MapFile{Data: data, Mode: 0o644, ModTime: storageTestTime}
+	return nil
+}
+
+func (m memWriteFS) Mkdir(name string) error {
+	m.MapFS[name] = &fstest.MapFile{Mode: fs.ModeDir | 0o755, ModTime: storageTestTime}
+	return nil
+}
+
+func (m memWriteFS) Remove(name string) error {
+	if _, ok := m.MapFS[name]; !ok {
+		return fs.ErrNotExist
+	}
+	delete(m.MapFS, name)
+	return nil
+}
+
+func (m memWriteFS) Rename(oldname, newname string) error {
+	file, ok := m.MapFS[oldname]
+	if !ok {
+		return fs.ErrNotExist
+	}
+	delete(m.MapFS, oldname)
+	m.MapFS[newname] = file
+	return nil
+}
+
+func TestStorageFS(t *testing.T) {
+	sfs := fsStorage(t, fstest.MapFS{
+		"readme.txt":             {Data: []byte("hello"), ModTime: storageTestTime},
+		"levels/1.map":           {Data: []byte("level one"), ModTime: storageTestTime},
+		"levels/2.map":           {Data: []byte("level two"), ModTime: storageTestTime},
+		"levels/extra/bonus.map": {Data: []byte{}, ModTime: storageTestTime},
+	})
+	if err := fstest.TestFS(sfs, "readme.txt", "levels/1.map", "levels/2.map", "levels/extra/bonus.map"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sfs.WriteFile("new.txt", []byte("x")); err == nil {
+		t.Error("a storage backed by a read-only file system accepted a write")
+	}
+}
+
+func TestStorageFSWrite(t *testing.T) {
+	backing := memWriteFS{fstest.MapFS{}}
+	sfs := fsStorage(t, backing)
+
+	if err := sfs.Mkdir("saves"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sfs.WriteFile("saves/slot1.sav", []byte("progress")); err != nil {
+		t.Fatal(err)
+	}
+	if file := backing.MapFS["saves/slot1.sav"]; file == nil || string(file.Data) != "progress" {
+		t.Fatalf("the backing file system holds %+v", file)
+	}
+	if data, err := fs.ReadFile(sfs, "saves/slot1.sav"); err != nil || string(data) != "progress" {
+		t.Errorf("ReadFile = %q, %v", data, err)
+	}
+
+	if err := sfs.Copy("saves/slot1.sav", "saves/backup.sav"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sfs.Rename("saves/slot1.sav", "saves/slot2.sav"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sfs.Remove("saves/backup.sav"); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := sfs.ReadDir("saves")
+	if err != nil || len(entries) != 1 || entries[0].Name() != "slot2.sav" {
+		t.Fatalf("ReadDir = %v, %v, want only slot2.sav", entries, err)
+	}
+	if info, err := sfs.Stat("saves/slot2.sav"); err != nil || info.Size() != int64(len("progress")) || !info.ModTime().Equal(storageTestTime) {
+		t.Errorf("Stat = %v, %v", info, err)
+	}
+	if _, err := sfs.Stat("saves/slot1.sav"); err == nil {
+		t.Error("the renamed file still exists")
+	}
+}
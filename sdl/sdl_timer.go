@@ -10,10 +10,12 @@ import "github.com/ebitengine/purego"
 //	return sdlAddTimerNS(interval, callback, userdata)
 // }
 
-// Delay wait a specified number of milliseconds before returning.
-// func Delay(ms uint32)  {
-//	sdlDelay(ms)
-// }
+// [Delay] waits a specified number of milliseconds before returning.
+//
+// [Delay]: https://wiki.libsdl.org/SDL3/SDL_Delay
+func Delay(ms uint32) {
+	sdlDelay(ms)
+}
 
 // [DelayNS] waits a specified number of nanoseconds before returning.
 //
@@ -1,5 +1,11 @@
 package sdl
 
+import (
+	"unsafe"
+
+	"github.com/jupiterrider/purego-sdl3/internal/mem"
+)
+
 type TouchDeviceType int32
 
 const (
@@ -13,18 +19,57 @@ type TouchID uint64
 
 type FingerID uint64
 
-// func GetTouchDeviceName(touchID TouchID) string {
-//	return sdlGetTouchDeviceName(touchID)
-// }
+// Finger is the data structure for a finger currently touching a touch device.
+// X and Y are normalized in the range 0...1.
+type Finger struct {
+	ID       FingerID
+	X        float32
+	Y        float32
+	Pressure float32
+}
+
+// [GetTouchDeviceName] gets the touch device name as reported from the driver or "" on failure.
+//
+// [GetTouchDeviceName]: https://wiki.libsdl.org/SDL3/SDL_GetTouchDeviceName
+func GetTouchDeviceName(touchID TouchID) string {
+	return sdlGetTouchDeviceName(touchID)
+}
 
-// func GetTouchDevices(count *int32) *TouchID {
-//	return sdlGetTouchDevices(count)
-// }
+// [GetTouchDevices] gets a list of registered touch devices.
+//
+// [GetTouchDevices]: https://wiki.libsdl.org/SDL3/SDL_GetTouchDevices
+func GetTouchDevices() []TouchID {
+	var count int32
+	devices := sdlGetTouchDevices(&count)
+	if devices == nil {
+		return nil
+	}
+	defer Free(unsafe.Pointer(devices))
+	return mem.Copy(devices, count)
+}
 
-// func GetTouchDeviceType(touchID TouchID) TouchDeviceType {
-//	return sdlGetTouchDeviceType(touchID)
-// }
+// [GetTouchDeviceType] gets the type of the given touch device.
+//
+// [GetTouchDeviceType]: https://wiki.libsdl.org/SDL3/SDL_GetTouchDeviceType
+func GetTouchDeviceType(touchID TouchID) TouchDeviceType {
+	return sdlGetTouchDeviceType(touchID)
+}
 
-// func GetTouchFingers(touchID TouchID, count *int32) **Finger {
-//	return sdlGetTouchFingers(touchID, count)
-// }
+// [GetTouchFingers] gets a list of active fingers for a given touch device.
+//
+// [GetTouchFingers]: https://wiki.libsdl.org/SDL3/SDL_GetTouchFingers
+func GetTouchFingers(touchID TouchID) []Finger {
+	var count int32
+	fingers := sdlGetTouchFingers(touchID, &count)
+	if fingers == nil {
+		return nil
+	}
+	defer Free(unsafe.Pointer(fingers))
+	result := make([]Finger, 0, count)
+	for _, finger := range unsafe.Slice(fingers, count) {
+		if finger != nil {
+			result = append(result, *finger)
+		}
+	}
+	return result
+}
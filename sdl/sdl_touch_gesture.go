@@ -0,0 +1,403 @@
+package sdl
+
+import (
+	"math"
+	"time"
+)
+
+// GestureType identifies the kind of gesture reported by a [GestureRecognizer].
+type GestureType int32
+
+const (
+	GestureTap GestureType = iota
+	GestureDoubleTap
+	GestureLongPress
+	GestureSwipe
+	GesturePinch
+	GestureRotate
+)
+
+func (g GestureType) String() string {
+	switch g {
+	case GestureTap:
+		return "tap"
+	case GestureDoubleTap:
+		return "double-tap"
+	case GestureLongPress:
+		return "long-press"
+	case GestureSwipe:
+		return "swipe"
+	case GesturePinch:
+		return "pinch"
+	case GestureRotate:
+		return "rotate"
+	}
+	return "unknown"
+}
+
+// SwipeDirection is the dominant direction of a [GestureSwipe].
+type SwipeDirection int32
+
+const (
+	SwipeLeft SwipeDirection = iota
+	SwipeRight
+	SwipeUp
+	SwipeDown
+)
+
+// Gesture is a gesture recognized from a sequence of [TouchFingerEvent]s.
+//
+// Coordinates are normalized in the range 0...1, just like the finger events they were derived from.
+type Gesture struct {
+	Type    GestureType
+	TouchID TouchID
+	// Timestamp in nanoseconds of the finger event that completed the gesture.
+	Timestamp uint64
+	// X and Y hold the position of the finger, or the centroid of both fingers for pinch and rotate.
+	X float32
+	Y float32
+	// DX and DY hold the total movement of a swipe.
+	DX        float32
+	DY        float32
+	Direction SwipeDirection
+	Duration  time.Duration
+	// Scale is the change in finger distance since the last pinch gesture (1 means unchanged).
+	Scale float32
+	// Rotation is the change in finger angle in radians since the last rotate gesture.
+	Rotation float32
+}
+
+// GestureConfig holds the thresholds used by a [GestureRecognizer].
+// Distances are in normalized touch coordinates.
+type GestureConfig struct {
+	// TapMaxDuration is the longest a finger may stay down for a tap.
+	TapMaxDuration time.Duration
+	// TapMaxDistance is the furthest a finger may move for a tap or a long press.
+	TapMaxDistance float32
+	// DoubleTapInterval is the longest time between two taps forming a double-tap.
+	DoubleTapInterval time.Duration
+	// DoubleTapMaxDistance is the furthest two taps may be apart to form a double-tap.
+	DoubleTapMaxDistance float32
+	// LongPressDuration is how long a finger has to stay down to trigger a long press.
+	LongPressDuration time.Duration
+	// SwipeMinDistance is the shortest movement recognized as a swipe.
+	SwipeMinDistance float32
+	// SwipeMaxDuration is the longest a finger may stay down for a swipe.
+	SwipeMaxDuration time.Duration
+	// PinchThreshold is the minimal relative change in finger distance reported as a pinch.
+	PinchThreshold float32
+	// RotateThreshold is the minimal change in finger angle (in radians) reported as a rotation.
+	RotateThreshold float32
+}
+
+// DefaultGestureConfig returns thresholds that work well for most touch screens.
+func DefaultGestureConfig() GestureConfig {
+	return GestureConfig{
+		TapMaxDuration:       250 * time.Millisecond,
+		TapMaxDistance:       0.02,
+		DoubleTapInterval:    300 * time.Millisecond,
+		DoubleTapMaxDistance: 0.05,
+		LongPressDuration:    500 * time.Millisecond,
+		SwipeMinDistance:     0.1,
+		SwipeMaxDuration:     500 * time.Millisecond,
+		PinchThreshold:       0.05,
+		RotateThreshold:      0.05,
+	}
+}
+
+type gestureFinger struct {
+	startX, startY float32
+	x, y           float32
+	start          uint64
+	moved          bool
+}
+
+type gestureTouch struct {
+	fingers map[FingerID]*gestureFinger
+	// order keeps the fingers in the order they went down, so that pinch and rotate use the first two.
+	order []FingerID
+	// multi is set as soon as a second finger went down and stays set until all fingers are lifted.
+	multi       bool
+	longPressed bool
+	distance    float32
+	angle       float32
+	lastTap     uint64
+	lastTapX    float32
+	lastTapY    float32
+	hasLastTap  bool
+}
+
+// GestureRecognizer turns [TouchFingerEvent]s into taps, double-taps, long presses, swipes, pinches and rotations.
+//
+// SDL3 does not recognize gestures by itself anymore. Feed every finger event to [GestureRecognizer.HandleEvent]
+// and call [GestureRecognizer.Update] once per frame, so that long presses are detected while the finger is not moving.
+//
+// A double-tap is always preceded by a tap for the first touch. The zero value is usable once Config is set.
+type GestureRecognizer struct {
+	Config  GestureConfig
+	touches map[TouchID]*gestureTouch
+}
+
+// NewGestureRecognizer creates a recognizer using the given thresholds.
+func NewGestureRecognizer(config GestureConfig) *GestureRecognizer {
+	return &GestureRecognizer{Config: config, touches: make(map[TouchID]*gestureTouch)}
+}
+
+// Reset forgets all fingers and pending taps.
+func (r *GestureRecognizer) Reset() {
+	r.touches = make(map[TouchID]*gestureTouch)
+}
+
+// HandleEvent processes a finger event and returns the gestures it completed, if any.
+// Events other than finger events are ignored.
+func (r *GestureRecognizer) HandleEvent(event *Event) []Gesture {
+	switch event.Type() {
+	case EventFingerDown, EventFingerUp, EventFingerMotion, EventFingerCanceled:
+		finger := event.TFinger()
+		return r.HandleFingerEvent(&finger)
+	}
+	return nil
+}
+
+// HandleFingerEvent processes a finger event and returns the gestures it completed, if any.
+// The event type is read from the embedded [CommonEvent].
+func (r *GestureRecognizer) HandleFingerEvent(event *TouchFingerEvent) []Gesture {
+	if r.touches == nil {
+		r.touches = make(map[TouchID]*gestureTouch)
+	}
+	touch := r.touches[event.TouchID]
+	if touch == nil {
+		touch = &gestureTouch{fingers: make(map[FingerID]*gestureFinger)}
+		r.touches[event.TouchID] = touch
+	}
+
+	switch event.Type {
+	case EventFingerDown:
+		r.fingerDown(touch, event)
+	case EventFingerMotion:
+		return r.fingerMotion(touch, event)
+	case EventFingerUp:
+		return r.fingerUp(touch, event)
+	case EventFingerCanceled:
+		r.removeFinger(touch, event.FingerID)
+		// a canceled touch must not produce a gesture once the remaining fingers are lifted
+		touch.multi = true
+		if len(touch.fingers) == 0 {
+			touch.multi = false
+			touch.longPressed = false
+		}
+	}
+	return nil
+}
+
+// Update checks for long presses at the given time in nanoseconds (see [GetTicksNS]).
+func (r *GestureRecognizer) Update(timestampNS uint64) []Gesture {
+	var gestures []Gesture
+	for id, touch := range r.touches {
+		if g, ok := r.checkLongPress(id, touch, timestampNS); ok {
+			gestures = append(gestures, g)
+		}
+	}
+	return gestures
+}
+
+func (r *GestureRecognizer) fingerDown(touch *gestureTouch, event *TouchFingerEvent) {
+	if _, ok := touch.fingers[event.FingerID]; !ok {
+		touch.order = append(touch.order, event.FingerID)
+	}
+	touch.fingers[event.FingerID] = &gestureFinger{
+		startX: event.X,
+		startY: event.Y,
+		x:      event.X,
+		y:      event.Y,
+		start:  event.Timestamp,
+	}
+	if len(touch.fingers) >= 2 {
+		touch.multi = true
+		touch.distance, touch.angle = touch.twoFingers()
+	}
+}
+
+func (r *GestureRecognizer) fingerMotion(touch *gestureTouch, event *TouchFingerEvent) []Gesture {
+	finger := touch.fingers[event.FingerID]
+	if finger == nil {
+		return nil
+	}
+	finger.x, finger.y = event.X, event.Y
+	if gestureDistance(finger.startX, finger.startY, finger.x, finger.y) > r.Config.TapMaxDistance {
+		finger.moved = true
+	}
+
+	var gestures []Gesture
+	if g, ok := r.checkLongPress(event.TouchID, touch, event.Timestamp); ok {
+		gestures = append(gestures, g)
+	}
+
+	if len(touch.fingers) < 2 {
+		return gestures
+	}
+
+	dist, angle := touch.twoFingers()
+	cx, cy := touch.centroid()
+	if touch.distance > 0 {
+		scale := dist / touch.distance
+		if float32(math.Abs(float64(scale-1))) >= r.Config.PinchThreshold {
+			gestures = append(gestures, Gesture{
+				Type:      GesturePinch,
+				TouchID:   event.TouchID,
+				Timestamp: event.Timestamp,
+				X:         cx,
+				Y:         cy,
+				Scale:     scale,
+			})
+			touch.distance = dist
+		}
+	}
+	rotation := normalizeAngle(angle - touch.angle)
+	if float32(math.Abs(float64(rotation))) >= r.Config.RotateThreshold {
+		gestures = append(gestures, Gesture{
+			Type:      GestureRotate,
+			TouchID:   event.TouchID,
+			Timestamp: event.Timestamp,
+			X:         cx,
+			Y:         cy,
+			Rotation:  rotation,
+		})
+		touch.angle = angle
+	}
+	return gestures
+}
+
+func (r *GestureRecognizer) fingerUp(touch *gestureTouch, event *TouchFingerEvent) []Gesture {
+	finger := touch.fingers[event.FingerID]
+	if finger == nil {
+		return nil
+	}
+	finger.x, finger.y = event.X, event.Y
+	r.removeFinger(touch, event.FingerID)
+
+	if touch.multi || touch.longPressed {
+		if len(touch.fingers) == 0 {
+			touch.multi = false
+			touch.longPressed = false
+		}
+		return nil
+	}
+
+	duration := time.Duration(event.Timestamp - finger.start)
+	dx, dy := finger.x-finger.startX, finger.y-finger.startY
+	moved := finger.moved || gestureDistance(0, 0, dx, dy) > r.Config.TapMaxDistance
+
+	if !moved && duration <= r.Config.TapMaxDuration {
+		gestures := []Gesture{{
+			Type:      GestureTap,
+			TouchID:   event.TouchID,
+			Timestamp: event.Timestamp,
+			X:         finger.x,
+			Y:         finger.y,
+			Duration:  duration,
+		}}
+		if touch.hasLastTap &&
+			time.Duration(event.Timestamp-touch.lastTap) <= r.Config.DoubleTapInterval &&
+			gestureDistance(touch.lastTapX, touch.lastTapY, finger.x, finger.y) <= r.Config.DoubleTapMaxDistance {
+			gestures[0].Type = GestureDoubleTap
+			touch.hasLastTap = false
+			return gestures
+		}
+		touch.hasLastTap = true
+		touch.lastTap = event.Timestamp
+		touch.lastTapX, touch.lastTapY = finger.x, finger.y
+		return gestures
+	}
+
+	if gestureDistance(0, 0, dx, dy) >= r.Config.SwipeMinDistance && duration <= r.Config.SwipeMaxDuration {
+		var direction SwipeDirection
+		if math.Abs(float64(dx)) >= math.Abs(float64(dy)) {
+			direction = SwipeRight
+			if dx < 0 {
+				direction = SwipeLeft
+			}
+		} else {
+			direction = SwipeDown
+			if dy < 0 {
+				direction = SwipeUp
+			}
+		}
+		return []Gesture{{
+			Type:      GestureSwipe,
+			TouchID:   event.TouchID,
+			Timestamp: event.Timestamp,
+			X:         finger.x,
+			Y:         finger.y,
+			DX:        dx,
+			DY:        dy,
+			Direction: direction,
+			Duration:  duration,
+		}}
+	}
+	return nil
+}
+
+func (r *GestureRecognizer) checkLongPress(id TouchID, touch *gestureTouch, now uint64) (Gesture, bool) {
+	if touch.multi || touch.longPressed || len(touch.fingers) != 1 {
+		return Gesture{}, false
+	}
+	finger := touch.fingers[touch.order[0]]
+	if finger.moved || now < finger.start {
+		return Gesture{}, false
+	}
+	duration := time.Duration(now - finger.start)
+	if duration < r.Config.LongPressDuration {
+		return Gesture{}, false
+	}
+	touch.longPressed = true
+	touch.hasLastTap = false
+	return Gesture{
+		Type:      GestureLongPress,
+		TouchID:   id,
+		Timestamp: now,
+		X:         finger.x,
+		Y:         finger.y,
+		Duration:  duration,
+	}, true
+}
+
+func (r *GestureRecognizer) removeFinger(touch *gestureTouch, id FingerID) {
+	delete(touch.fingers, id)
+	for i, v := range touch.order {
+		if v == id {
+			touch.order = append(touch.order[:i], touch.order[i+1:]...)
+			break
+		}
+	}
+	if len(touch.fingers) >= 2 {
+		touch.distance, touch.angle = touch.twoFingers()
+	}
+}
+
+// twoFingers returns the distance and angle between the first two fingers.
+func (t *gestureTouch) twoFingers() (float32, float32) {
+	a, b := t.fingers[t.order[0]], t.fingers[t.order[1]]
+	angle := float32(math.Atan2(float64(b.y-a.y), float64(b.x-a.x)))
+	return gestureDistance(a.x, a.y, b.x, b.y), angle
+}
+
+func (t *gestureTouch) centroid() (float32, float32) {
+	a, b := t.fingers[t.order[0]], t.fingers[t.order[1]]
+	return (a.x + b.x) / 2, (a.y + b.y) / 2
+}
+
+func gestureDistance(x1, y1, x2, y2 float32) float32 {
+	return float32(math.Hypot(float64(x2-x1), float64(y2-y1)))
+}
+
+// normalizeAngle wraps an angle into the range -π...π.
+func normalizeAngle(angle float32) float32 {
+	for angle > math.Pi {
+		angle -= 2 * math.Pi
+	}
+	for angle < -math.Pi {
+		angle += 2 * math.Pi
+	}
+	return angle
+}
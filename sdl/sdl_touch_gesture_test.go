@@ -0,0 +1,148 @@
+package sdl
+
+import (
+	"testing"
+	"time"
+)
+
+const gestureTouchID TouchID = 1
+
+func fingerEvent(eventType EventType, finger FingerID, at time.Duration, x, y float32) *TouchFingerEvent {
+	return &TouchFingerEvent{
+		CommonEvent: CommonEvent{Type: eventType, Timestamp: uint64(at)},
+		TouchID:     gestureTouchID,
+		FingerID:    finger,
+		X:           x,
+		Y:           y,
+	}
+}
+
+// feed passes the events to r and returns all gestures they completed.
+func feed(r *GestureRecognizer, events ...*TouchFingerEvent) []Gesture {
+	var gestures []Gesture
+	for _, event := range events {
+		gestures = append(gestures, r.HandleFingerEvent(event)...)
+	}
+	return gestures
+}
+
+func TestGestureTap(t *testing.T) {
+	r := NewGestureRecognizer(DefaultGestureConfig())
+	gestures := feed(r,
+		fingerEvent(EventFingerDown, 1, 0, 0.5, 0.5),
+		fingerEvent(EventFingerUp, 1, 100*time.Millisecond, 0.505, 0.5),
+	)
+	if len(gestures) != 1 || gestures[0].Type != GestureTap {
+		t.Fatalf("got %+v, want a single tap", gestures)
+	}
+	if gestures[0].Duration != 100*time.Millisecond {
+		t.Errorf("duration = %v, want 100ms", gestures[0].Duration)
+	}
+
+	gestures = feed(r,
+		fingerEvent(EventFingerDown, 1, 200*time.Millisecond, 0.51, 0.5),
+		fingerEvent(EventFingerUp, 1, 250*time.Millisecond, 0.51, 0.5),
+	)
+	if len(gestures) != 1 || gestures[0].Type != GestureDoubleTap {
+		t.Fatalf("got %+v, want a double-tap", gestures)
+	}
+}
+
+func TestGestureZeroValue(t *testing.T) {
+	r := GestureRecognizer{Config: DefaultGestureConfig()}
+	gestures := feed(&r,
+		fingerEvent(EventFingerDown, 1, 0, 0.5, 0.5),
+		fingerEvent(EventFingerUp, 1, 50*time.Millisecond, 0.5, 0.5),
+	)
+	if len(gestures) != 1 || gestures[0].Type != GestureTap {
+		t.Fatalf("got %+v, want a single tap", gestures)
+	}
+}
+
+func TestGestureLongPress(t *testing.T) {
+	r := NewGestureRecognizer(DefaultGestureConfig())
+	feed(r, fingerEvent(EventFingerDown, 1, 0, 0.5, 0.5))
+	if gestures := r.Update(uint64(100 * time.Millisecond)); len(gestures) != 0 {
+		t.Fatalf("got %+v before the long press duration", gestures)
+	}
+	gestures := r.Update(uint64(600 * time.Millisecond))
+	if len(gestures) != 1 || gestures[0].Type != GestureLongPress {
+		t.Fatalf("got %+v, want a long press", gestures)
+	}
+	if gestures := feed(r, fingerEvent(EventFingerUp, 1, 700*time.Millisecond, 0.5, 0.5)); len(gestures) != 0 {
+		t.Fatalf("got %+v after a long press, want nothing", gestures)
+	}
+}
+
+func TestGestureSwipe(t *testing.T) {
+	tests := []struct {
+		dx, dy    float32
+		direction SwipeDirection
+	}{
+		{0.3, 0.05, SwipeRight},
+		{-0.3, 0.05, SwipeLeft},
+		{0.05, -0.3, SwipeUp},
+		{0.05, 0.3, SwipeDown},
+	}
+	for _, test := range tests {
+		r := NewGestureRecognizer(DefaultGestureConfig())
+		gestures := feed(r,
+			fingerEvent(EventFingerDown, 1, 0, 0.5, 0.5),
+			fingerEvent(EventFingerMotion, 1, 50*time.Millisecond, 0.5+test.dx/2, 0.5+test.dy/2),
+			fingerEvent(EventFingerUp, 1, 100*time.Millisecond, 0.5+test.dx, 0.5+test.dy),
+		)
+		if len(gestures) != 1 || gestures[0].Type != GestureSwipe {
+			t.Fatalf("dx=%v dy=%v: got %+v, want a swipe", test.dx, test.dy, gestures)
+		}
+		if gestures[0].Direction != test.direction {
+			t.Errorf("dx=%v dy=%v: direction = %v, want %v", test.dx, test.dy, gestures[0].Direction, test.direction)
+		}
+	}
+}
+
+func TestGestureSwipeTooSlow(t *testing.T) {
+	r := NewGestureRecognizer(DefaultGestureConfig())
+	gestures := feed(r,
+		fingerEvent(EventFingerDown, 1, 0, 0.2, 0.5),
+		fingerEvent(EventFingerMotion, 1, 100*time.Millisecond, 0.4, 0.5),
+		fingerEvent(EventFingerUp, 1, time.Second, 0.6, 0.5),
+	)
+	if len(gestures) != 0 {
+		t.Fatalf("got %+v, want nothing", gestures)
+	}
+}
+
+func TestGesturePinch(t *testing.T) {
+	r := NewGestureRecognizer(DefaultGestureConfig())
+	gestures := feed(r,
+		fingerEvent(EventFingerDown, 1, 0, 0.4, 0.5),
+		fingerEvent(EventFingerDown, 2, 10*time.Millisecond, 0.6, 0.5),
+		fingerEvent(EventFingerMotion, 2, 50*time.Millisecond, 0.8, 0.5),
+	)
+	var pinch *Gesture
+	for i := range gestures {
+		if gestures[i].Type == GesturePinch {
+			pinch = &gestures[i]
+		} else {
+			t.Errorf("unexpected %v", gestures[i].Type)
+		}
+	}
+	if pinch == nil {
+		t.Fatalf("got %+v, want a pinch", gestures)
+	}
+	if pinch.Scale < 1.99 || pinch.Scale > 2.01 {
+		t.Errorf("scale = %v, want 2", pinch.Scale)
+	}
+	if pinch.X < 0.59 || pinch.X > 0.61 {
+		t.Errorf("centroid x = %v, want 0.6", pinch.X)
+	}
+
+	// lifting the fingers of a pinch must not produce a tap
+	gestures = feed(r,
+		fingerEvent(EventFingerUp, 1, 100*time.Millisecond, 0.4, 0.5),
+		fingerEvent(EventFingerUp, 2, 110*time.Millisecond, 0.8, 0.5),
+	)
+	if len(gestures) != 0 {
+		t.Fatalf("got %+v after the pinch, want nothing", gestures)
+	}
+}